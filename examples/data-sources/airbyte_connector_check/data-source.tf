@@ -0,0 +1,23 @@
+# Check a configuration before creating the source
+data "airbyte_connector_check" "postgres" {
+  source_definition_id = "decd338e-5647-4c0b-adf4-da0e75f5a750"
+  connection_configuration = jsonencode({
+    host     = "db.internal"
+    port     = 5432
+    database = "app"
+    username = "airbyte"
+    password = var.postgres_password
+  })
+
+  lifecycle {
+    postcondition {
+      condition     = self.status == "succeeded"
+      error_message = "Airbyte could not connect to Postgres: ${self.message}"
+    }
+  }
+}
+
+# Check the saved configuration of an existing source
+data "airbyte_connector_check" "existing" {
+  source_id = airbyte_source.test.id
+}
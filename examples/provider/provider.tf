@@ -5,5 +5,29 @@ provider "airbyte" {
   additional_headers = {
     Host = "airbyte.internal"
   }
-  timeout = 120
+  timeout         = 120
+  startup_timeout = 180
+}
+
+provider "airbyte" {
+  alias    = "token"
+  host_url = "https://airbyte.example.com"
+  auth {
+    mode          = "client_credentials"
+    client_id     = "my-application-client-id"
+    client_secret = "my-application-client-secret"
+  }
+}
+
+provider "airbyte" {
+  alias      = "cloud"
+  api_flavor = "public"
+  auth {
+    api_key = "my-airbyte-cloud-api-key"
+  }
+}
+
+provider "airbyte" {
+  alias   = "staging"
+  profile = "staging"
 }
@@ -0,0 +1,225 @@
+package airbytefake
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+)
+
+func (s *Server) registerConnections() {
+	s.handle("connections/create", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.NewConnection{}
+		if !decode(w, r, &body) {
+			return
+		}
+		if validationErrors := validateConnection(body.CommonConnectionFields); len(validationErrors) > 0 {
+			invalid(w, validationErrors...)
+			return
+		}
+		source := s.connector(body.SourceId, apiclient.SourceType)
+		if source == nil {
+			notFound(w, connectorKinds[apiclient.SourceType].configType, body.SourceId)
+			return
+		}
+		destination := s.connector(body.DestinationId, apiclient.DestinationType)
+		if destination == nil {
+			notFound(w, connectorKinds[apiclient.DestinationType].configType, body.DestinationId)
+			return
+		}
+		if id, ok := s.missingOperation(body.OperationIds); !ok {
+			notFound(w, "STANDARD_SYNC_OPERATION", id)
+			return
+		}
+
+		connection := &apiclient.Connection{
+			ConnectionIdBody:       apiclient.ConnectionIdBody{ConnectionId: newId()},
+			CommonConnectionFields: body.CommonConnectionFields,
+			SourceIdBody:           body.SourceIdBody,
+			DestinationIdBody:      body.DestinationIdBody,
+			Geography:              "auto",
+		}
+		if connection.Name == "" {
+			connection.Name = fmt.Sprintf("%s <> %s", source.Name, destination.Name)
+		}
+		if connection.NamespaceDefinition == "" {
+			connection.NamespaceDefinition = "source"
+		}
+		if connection.ScheduleType == "" {
+			connection.ScheduleType = "manual"
+		}
+		if connection.OperationIds == nil {
+			connection.OperationIds = []string{}
+		}
+		if connection.BreakingChange == nil {
+			connection.BreakingChange = boolPtr(false)
+		}
+		s.connections = append(s.connections, connection)
+		writeJSON(w, connection)
+	})
+
+	s.handle("connections/get", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.ConnectionIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		connection := s.connection(body.ConnectionId)
+		if connection == nil {
+			notFound(w, "STANDARD_SYNC", body.ConnectionId)
+			return
+		}
+		writeJSON(w, connection)
+	})
+
+	s.handle("connections/list", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.WorkspaceIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		if s.workspace(body.WorkspaceId) == nil {
+			notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+			return
+		}
+		list := []*apiclient.Connection{}
+		for _, connection := range s.connections {
+			if source := s.connector(connection.SourceId, apiclient.SourceType); source != nil && source.WorkspaceId == body.WorkspaceId {
+				list = append(list, connection)
+			}
+		}
+		writeJSON(w, apiclient.ConnectionList{Connections: list})
+	})
+
+	s.handle("connections/update", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.UpdatedConnection{}
+		if !decode(w, r, &body) {
+			return
+		}
+		if validationErrors := validateConnection(body.CommonConnectionFields); len(validationErrors) > 0 {
+			invalid(w, validationErrors...)
+			return
+		}
+		connection := s.connection(body.ConnectionId)
+		if connection == nil {
+			notFound(w, "STANDARD_SYNC", body.ConnectionId)
+			return
+		}
+		if id, ok := s.missingOperation(body.OperationIds); !ok {
+			notFound(w, "STANDARD_SYNC_OPERATION", id)
+			return
+		}
+
+		// Like Airbyte, only the fields that are set are updated
+		update := body.CommonConnectionFields
+		connection.Status = update.Status
+		if update.Name != "" {
+			connection.Name = update.Name
+		}
+		if update.NamespaceDefinition != "" {
+			connection.NamespaceDefinition = update.NamespaceDefinition
+		}
+		if update.NamespaceFormat != "" {
+			connection.NamespaceFormat = update.NamespaceFormat
+		}
+		if update.Prefix != "" {
+			connection.Prefix = update.Prefix
+		}
+		if update.OperationIds != nil {
+			connection.OperationIds = update.OperationIds
+		}
+		if update.SyncCatalog != nil {
+			connection.SyncCatalog = update.SyncCatalog
+		}
+		if update.ScheduleType != "" {
+			connection.ScheduleType = update.ScheduleType
+			connection.ScheduleData = update.ScheduleData
+		}
+		if update.ResourceRequirements != nil {
+			connection.ResourceRequirements = update.ResourceRequirements
+		}
+		if update.SourceCatalogId != "" {
+			connection.SourceCatalogId = update.SourceCatalogId
+		}
+		writeJSON(w, connection)
+	})
+
+	s.handle("connections/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.ConnectionIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		for i, connection := range s.connections {
+			if connection.ConnectionId == body.ConnectionId {
+				s.connections = append(s.connections[:i], s.connections[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		notFound(w, "STANDARD_SYNC", body.ConnectionId)
+	})
+}
+
+func (s *Server) connection(id string) *apiclient.Connection {
+	for _, connection := range s.connections {
+		if connection.ConnectionId == id {
+			return connection
+		}
+	}
+	return nil
+}
+
+// missingOperation returns the first of ids that doesn't exist
+func (s *Server) missingOperation(ids []string) (string, bool) {
+	for _, id := range ids {
+		if s.operation(id) == nil {
+			return id, false
+		}
+	}
+	return "", true
+}
+
+func validateConnection(fields apiclient.CommonConnectionFields) []apiclient.PropertyValidationError {
+	var validationErrors []apiclient.PropertyValidationError
+	if !oneOf(fields.Status, "active", "inactive", "deprecated") {
+		validationErrors = append(validationErrors, apiclient.PropertyValidationError{
+			PropertyPath: "status",
+			InvalidValue: fields.Status,
+			Message:      "must be one of active, inactive, deprecated",
+		})
+	}
+	if fields.SyncCatalog == nil {
+		return validationErrors
+	}
+
+	for i, stream := range fields.SyncCatalog.Streams {
+		if stream.Stream.Name == "" {
+			validationErrors = append(validationErrors, required(fmt.Sprintf("syncCatalog.streams[%d].stream.name", i)))
+		}
+		syncModes := stream.Stream.SupportedSyncModes
+		if len(syncModes) == 0 {
+			syncModes = []string{"full_refresh", "incremental"}
+		}
+		if !oneOf(stream.Config.SyncMode, syncModes...) {
+			validationErrors = append(validationErrors, apiclient.PropertyValidationError{
+				PropertyPath: fmt.Sprintf("syncCatalog.streams[%d].config.syncMode", i),
+				InvalidValue: stream.Config.SyncMode,
+				Message:      "sync mode is not supported by the stream",
+			})
+		}
+		if !oneOf(stream.Config.DestinationSyncMode, "append", "overwrite", "append_dedup") {
+			validationErrors = append(validationErrors, apiclient.PropertyValidationError{
+				PropertyPath: fmt.Sprintf("syncCatalog.streams[%d].config.destinationSyncMode", i),
+				InvalidValue: stream.Config.DestinationSyncMode,
+				Message:      "must be one of append, overwrite, append_dedup",
+			})
+		}
+	}
+	return validationErrors
+}
+
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package airbytefake
+
+import (
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+)
+
+func (s *Server) registerConnectorDefinitions() {
+	for _, t := range []apiclient.ConnectorType{apiclient.SourceType, apiclient.DestinationType} {
+		t := t
+		kind := connectorKinds[t]
+
+		s.handle(kind.definitionPath+"/create_custom", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.NewConnectorDefinition{}
+			if !decode(w, r, &body) {
+				return
+			}
+			fields, prefix := body.SourceDefinition, "sourceDefinition"
+			if t == apiclient.DestinationType {
+				fields, prefix = body.DestinationDefinition, "destinationDefinition"
+			}
+			if fields == nil {
+				invalid(w, required(prefix))
+				return
+			}
+			validationErrors := requiredFields(prefix+".", [][2]string{
+				{"name", fields.Name},
+				{"dockerRepository", fields.DockerRepository},
+				{"dockerImageTag", fields.DockerImageTag},
+				{"documentationUrl", fields.DocumentationUrl},
+			})
+			if len(validationErrors) > 0 {
+				invalid(w, validationErrors...)
+				return
+			}
+			if s.workspace(body.WorkspaceId) == nil {
+				notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+				return
+			}
+
+			definition := &apiclient.ConnectorDefinition{
+				CommonConnectorDefinitionFields: *fields,
+				ProtocolVersion:                 "0.2.0",
+				ReleaseStage:                    "custom",
+			}
+			definition.ResourceRequirements = withJobSpecificDefault(definition.ResourceRequirements)
+			if t == apiclient.SourceType {
+				definition.SourceDefinitionId = newId()
+				s.sourceDefinitions = append(s.sourceDefinitions, definition)
+			} else {
+				definition.DestinationDefinitionId = newId()
+				s.destinationDefinitions = append(s.destinationDefinitions, definition)
+			}
+			writeJSON(w, definition)
+		})
+
+		s.handle(kind.definitionPath+"/get", func(w http.ResponseWriter, r *http.Request) {
+			id, ok := decodeDefinitionId(w, r, t)
+			if !ok {
+				return
+			}
+			definition := s.connectorDefinition(id, t)
+			if definition == nil {
+				notFound(w, kind.definitionConfigType, id)
+				return
+			}
+			writeJSON(w, definition)
+		})
+
+		s.handle(kind.definitionPath+"/update", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.UpdatedConnectorDefinition{}
+			if !decode(w, r, &body) {
+				return
+			}
+			id := body.SourceDefinitionId
+			if t == apiclient.DestinationType {
+				id = body.DestinationDefinitionId
+			}
+			definition := s.connectorDefinition(id, t)
+			if definition == nil {
+				notFound(w, kind.definitionConfigType, id)
+				return
+			}
+			if body.DockerImageTag != "" {
+				definition.DockerImageTag = body.DockerImageTag
+			}
+			definition.ResourceRequirements = withJobSpecificDefault(body.ResourceRequirements)
+			writeJSON(w, definition)
+		})
+
+		s.handle(kind.definitionPath+"/delete", func(w http.ResponseWriter, r *http.Request) {
+			id, ok := decodeDefinitionId(w, r, t)
+			if !ok {
+				return
+			}
+			definitions := &s.sourceDefinitions
+			if t == apiclient.DestinationType {
+				definitions = &s.destinationDefinitions
+			}
+			for i, definition := range *definitions {
+				if definitionIdOf(definition.SourceDefinitionIdBody, definition.DestinationDefinitionIdBody, t) == id {
+					*definitions = append((*definitions)[:i], (*definitions)[i+1:]...)
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+			notFound(w, kind.definitionConfigType, id)
+		})
+	}
+}
+
+func decodeDefinitionId(w http.ResponseWriter, r *http.Request, t apiclient.ConnectorType) (string, bool) {
+	body := struct {
+		apiclient.SourceDefinitionIdBody
+		apiclient.DestinationDefinitionIdBody
+	}{}
+	if !decode(w, r, &body) {
+		return "", false
+	}
+	if t == apiclient.SourceType {
+		return body.SourceDefinitionId, true
+	}
+	return body.DestinationDefinitionId, true
+}
+
+func (s *Server) connectorDefinition(id string, t apiclient.ConnectorType) *apiclient.ConnectorDefinition {
+	definitions := s.sourceDefinitions
+	if t == apiclient.DestinationType {
+		definitions = s.destinationDefinitions
+	}
+	for _, definition := range definitions {
+		if definitionIdOf(definition.SourceDefinitionIdBody, definition.DestinationDefinitionIdBody, t) == id {
+			return definition
+		}
+	}
+	return nil
+}
+
+// withJobSpecificDefault returns requirements with an empty list of job specific requirements if
+// none are set, as Airbyte always returns the list once any requirements are set.
+func withJobSpecificDefault(requirements *apiclient.ResourceRequirements) *apiclient.ResourceRequirements {
+	if requirements != nil && requirements.JobSpecific == nil {
+		requirements.JobSpecific = &[]apiclient.JobSpecificResourceRequirements{}
+	}
+	return requirements
+}
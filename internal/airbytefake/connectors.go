@@ -0,0 +1,282 @@
+package airbytefake
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+)
+
+// connectorKind holds what differs between the endpoints of sources and destinations
+type connectorKind struct {
+	path                 string
+	definitionPath       string
+	configType           string
+	definitionConfigType string
+	checkJobConfigType   string
+}
+
+var connectorKinds = map[apiclient.ConnectorType]connectorKind{
+	apiclient.SourceType: {
+		path:                 "sources",
+		definitionPath:       "source_definitions",
+		configType:           "SOURCE_CONNECTION",
+		definitionConfigType: "STANDARD_SOURCE_DEFINITION",
+		checkJobConfigType:   "check_connection_source",
+	},
+	apiclient.DestinationType: {
+		path:                 "destinations",
+		definitionPath:       "destination_definitions",
+		configType:           "DESTINATION_CONNECTION",
+		definitionConfigType: "STANDARD_DESTINATION_DEFINITION",
+		checkJobConfigType:   "check_connection_destination",
+	},
+}
+
+func (s *Server) registerConnectors() {
+	for _, t := range []apiclient.ConnectorType{apiclient.SourceType, apiclient.DestinationType} {
+		t := t
+		kind := connectorKinds[t]
+
+		s.handle("scheduler/"+kind.path+"/check_connection", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.NewConnector{}
+			if !decode(w, r, &body) {
+				return
+			}
+			definitionId := definitionIdOf(body.SourceDefinitionIdBody, body.DestinationDefinitionIdBody, t)
+			definition := s.connectorDefinition(definitionId, t)
+			if definition == nil {
+				notFound(w, kind.definitionConfigType, definitionId)
+				return
+			}
+			writeJSON(w, s.checkConnection(kind, definition, body.ConnectionConfiguration))
+		})
+
+		s.handle(kind.path+"/check_connection_for_update", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.UpdatedConnector{}
+			if !decode(w, r, &body) {
+				return
+			}
+			id := connectorIdOf(body.SourceIdBody, body.DestinationIdBody, t)
+			connector := s.connector(id, t)
+			if connector == nil {
+				notFound(w, kind.configType, id)
+				return
+			}
+			definitionId := definitionIdOf(connector.SourceDefinitionIdBody, connector.DestinationDefinitionIdBody, t)
+			writeJSON(w, s.checkConnection(kind, s.connectorDefinition(definitionId, t), body.ConnectionConfiguration))
+		})
+
+		s.handle(kind.path+"/check_connection", func(w http.ResponseWriter, r *http.Request) {
+			id, ok := decodeConnectorId(w, r, t)
+			if !ok {
+				return
+			}
+			connector := s.connector(id, t)
+			if connector == nil {
+				notFound(w, kind.configType, id)
+				return
+			}
+			definitionId := definitionIdOf(connector.SourceDefinitionIdBody, connector.DestinationDefinitionIdBody, t)
+			writeJSON(w, s.checkConnection(kind, s.connectorDefinition(definitionId, t), connector.ConnectionConfiguration))
+		})
+
+		s.handle(kind.path+"/create", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.NewConnector{}
+			if !decode(w, r, &body) {
+				return
+			}
+			validationErrors := requiredFields("", [][2]string{{"name", body.Name}, {"workspaceId", body.WorkspaceId}})
+			if len(body.ConnectionConfiguration) == 0 || string(body.ConnectionConfiguration) == "null" {
+				validationErrors = append(validationErrors, required("connectionConfiguration"))
+			}
+			if len(validationErrors) > 0 {
+				invalid(w, validationErrors...)
+				return
+			}
+			if s.workspace(body.WorkspaceId) == nil {
+				notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+				return
+			}
+			definitionId := definitionIdOf(body.SourceDefinitionIdBody, body.DestinationDefinitionIdBody, t)
+			definition := s.connectorDefinition(definitionId, t)
+			if definition == nil {
+				notFound(w, kind.definitionConfigType, definitionId)
+				return
+			}
+
+			connector := &apiclient.Connector{
+				SourceDefinitionIdBody:      body.SourceDefinitionIdBody,
+				DestinationDefinitionIdBody: body.DestinationDefinitionIdBody,
+				WorkspaceIdBody:             body.WorkspaceIdBody,
+				CommonConnectorFields:       body.CommonConnectorFields,
+				Icon:                        definition.Icon,
+			}
+			if t == apiclient.SourceType {
+				connector.SourceId = newId()
+				connector.SourceName = definition.Name
+				s.sources = append(s.sources, connector)
+			} else {
+				connector.DestinationId = newId()
+				connector.DestinationName = definition.Name
+				s.destinations = append(s.destinations, connector)
+			}
+			writeJSON(w, connector)
+		})
+
+		s.handle(kind.path+"/get", func(w http.ResponseWriter, r *http.Request) {
+			id, ok := decodeConnectorId(w, r, t)
+			if !ok {
+				return
+			}
+			connector := s.connector(id, t)
+			if connector == nil {
+				notFound(w, kind.configType, id)
+				return
+			}
+			writeJSON(w, connector)
+		})
+
+		s.handle(kind.path+"/list", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.WorkspaceIdBody{}
+			if !decode(w, r, &body) {
+				return
+			}
+			if s.workspace(body.WorkspaceId) == nil {
+				notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+				return
+			}
+			connectors := s.sources
+			if t == apiclient.DestinationType {
+				connectors = s.destinations
+			}
+			list := []*apiclient.Connector{}
+			for _, connector := range connectors {
+				if connector.WorkspaceId == body.WorkspaceId {
+					list = append(list, connector)
+				}
+			}
+			if t == apiclient.SourceType {
+				writeJSON(w, apiclient.ConnectorList{Sources: list})
+			} else {
+				writeJSON(w, apiclient.ConnectorList{Destinations: list})
+			}
+		})
+
+		s.handle(kind.path+"/update", func(w http.ResponseWriter, r *http.Request) {
+			body := apiclient.UpdatedConnector{}
+			if !decode(w, r, &body) {
+				return
+			}
+			if validationErrors := requiredFields("", [][2]string{{"name", body.Name}}); len(validationErrors) > 0 {
+				invalid(w, validationErrors...)
+				return
+			}
+			id := connectorIdOf(body.SourceIdBody, body.DestinationIdBody, t)
+			connector := s.connector(id, t)
+			if connector == nil {
+				notFound(w, kind.configType, id)
+				return
+			}
+			connector.Name = body.Name
+			connector.ConnectionConfiguration = body.ConnectionConfiguration
+			writeJSON(w, connector)
+		})
+
+		s.handle(kind.path+"/delete", func(w http.ResponseWriter, r *http.Request) {
+			id, ok := decodeConnectorId(w, r, t)
+			if !ok {
+				return
+			}
+			connectors := &s.sources
+			if t == apiclient.DestinationType {
+				connectors = &s.destinations
+			}
+			for i, connector := range *connectors {
+				if connectorIdOf(connector.SourceIdBody, connector.DestinationIdBody, t) == id {
+					*connectors = append((*connectors)[:i], (*connectors)[i+1:]...)
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+			notFound(w, kind.configType, id)
+		})
+	}
+
+	s.handle("sources/discover_schema", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := decodeConnectorId(w, r, apiclient.SourceType)
+		if !ok {
+			return
+		}
+		source := s.connector(id, apiclient.SourceType)
+		if source == nil {
+			notFound(w, connectorKinds[apiclient.SourceType].configType, id)
+			return
+		}
+		catalog := apiclient.SyncCatalog{Streams: []apiclient.Stream{}}
+		if definition := s.connectorDefinition(source.SourceDefinitionId, apiclient.SourceType); definition != nil {
+			if c, ok := s.Catalogs[definition.DockerRepository]; ok {
+				catalog = c
+			}
+		}
+		writeJSON(w, apiclient.SourceSchemaCatalog{
+			Catalog: catalog,
+			JobInfo: s.newJob("discover_schema", true),
+		})
+	})
+}
+
+func (s *Server) checkConnection(kind connectorKind, definition *apiclient.ConnectorDefinition, configuration json.RawMessage) apiclient.CheckConnectionResponse {
+	succeeded, message := true, ""
+	if s.CheckConnection != nil && definition != nil {
+		succeeded, message = s.CheckConnection(definition.DockerRepository, configuration)
+	}
+	status := "succeeded"
+	logLines := []string{"Checking connection...", "Connection check succeeded"}
+	if !succeeded {
+		status = "failed"
+		logLines = []string{"Checking connection...", "Connection check failed: " + message}
+	}
+	return apiclient.CheckConnectionResponse{
+		Status:  status,
+		Message: message,
+		JobInfo: s.newJob(kind.checkJobConfigType, true, logLines...),
+	}
+}
+
+func decodeConnectorId(w http.ResponseWriter, r *http.Request, t apiclient.ConnectorType) (string, bool) {
+	body := struct {
+		apiclient.SourceIdBody
+		apiclient.DestinationIdBody
+	}{}
+	if !decode(w, r, &body) {
+		return "", false
+	}
+	return connectorIdOf(body.SourceIdBody, body.DestinationIdBody, t), true
+}
+
+func connectorIdOf(source apiclient.SourceIdBody, destination apiclient.DestinationIdBody, t apiclient.ConnectorType) string {
+	if t == apiclient.SourceType {
+		return source.SourceId
+	}
+	return destination.DestinationId
+}
+
+func definitionIdOf(source apiclient.SourceDefinitionIdBody, destination apiclient.DestinationDefinitionIdBody, t apiclient.ConnectorType) string {
+	if t == apiclient.SourceType {
+		return source.SourceDefinitionId
+	}
+	return destination.DestinationDefinitionId
+}
+
+func (s *Server) connector(id string, t apiclient.ConnectorType) *apiclient.Connector {
+	connectors := s.sources
+	if t == apiclient.DestinationType {
+		connectors = s.destinations
+	}
+	for _, connector := range connectors {
+		if connectorIdOf(connector.SourceIdBody, connector.DestinationIdBody, t) == id {
+			return connector
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package airbytefake
+
+import (
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+)
+
+func (s *Server) registerOperations() {
+	s.handle("operations/check", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.OperationConfig{}
+		if !decode(w, r, &body) {
+			return
+		}
+		if validationErrors := validateOperatorConfiguration("", body); len(validationErrors) > 0 {
+			writeJSON(w, apiclient.OperationCheckResponse{Status: "failed", Message: validationErrors[0].Message})
+			return
+		}
+		writeJSON(w, apiclient.OperationCheckResponse{Status: "succeeded"})
+	})
+
+	s.handle("operations/create", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.NewOperation{}
+		if !decode(w, r, &body) {
+			return
+		}
+		validationErrors := requiredFields("", [][2]string{{"name", body.Name}, {"workspaceId", body.WorkspaceId}})
+		validationErrors = append(validationErrors, validateOperatorConfiguration("operatorConfiguration.", body.OperatorConfiguration)...)
+		if len(validationErrors) > 0 {
+			invalid(w, validationErrors...)
+			return
+		}
+		if s.workspace(body.WorkspaceId) == nil {
+			notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+			return
+		}
+
+		operation := &apiclient.Operation{
+			WorkspaceIdBody:       body.WorkspaceIdBody,
+			OperationIdBody:       apiclient.OperationIdBody{OperationId: newId()},
+			CommonOperationFields: body.CommonOperationFields,
+		}
+		s.operations = append(s.operations, operation)
+		writeJSON(w, operation)
+	})
+
+	s.handle("operations/get", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.OperationIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		operation := s.operation(body.OperationId)
+		if operation == nil {
+			notFound(w, "STANDARD_SYNC_OPERATION", body.OperationId)
+			return
+		}
+		writeJSON(w, operation)
+	})
+
+	s.handle("operations/update", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.UpdatedOperation{}
+		if !decode(w, r, &body) {
+			return
+		}
+		validationErrors := requiredFields("", [][2]string{{"name", body.Name}})
+		validationErrors = append(validationErrors, validateOperatorConfiguration("operatorConfiguration.", body.OperatorConfiguration)...)
+		if len(validationErrors) > 0 {
+			invalid(w, validationErrors...)
+			return
+		}
+		operation := s.operation(body.OperationId)
+		if operation == nil {
+			notFound(w, "STANDARD_SYNC_OPERATION", body.OperationId)
+			return
+		}
+		operation.CommonOperationFields = body.CommonOperationFields
+		writeJSON(w, operation)
+	})
+
+	s.handle("operations/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.OperationIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		for i, operation := range s.operations {
+			if operation.OperationId == body.OperationId {
+				s.operations = append(s.operations[:i], s.operations[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		notFound(w, "STANDARD_SYNC_OPERATION", body.OperationId)
+	})
+}
+
+func (s *Server) operation(id string) *apiclient.Operation {
+	for _, operation := range s.operations {
+		if operation.OperationId == id {
+			return operation
+		}
+	}
+	return nil
+}
+
+func validateOperatorConfiguration(prefix string, config apiclient.OperationConfig) []apiclient.PropertyValidationError {
+	switch config.OperatorType {
+	case "normalization":
+		if config.Normalization == nil {
+			return []apiclient.PropertyValidationError{required(prefix + "normalization")}
+		}
+	case "dbt":
+		if config.Dbt == nil || config.Dbt.GitRepoUrl == "" {
+			return []apiclient.PropertyValidationError{required(prefix + "dbt.gitRepoUrl")}
+		}
+	case "webhook":
+		if config.Webhook == nil || config.Webhook.ExecutionUrl == "" {
+			return []apiclient.PropertyValidationError{required(prefix + "webhook.executionUrl")}
+		}
+	default:
+		return []apiclient.PropertyValidationError{{
+			PropertyPath: prefix + "operatorType",
+			InvalidValue: config.OperatorType,
+			Message:      "must be one of normalization, dbt, webhook",
+		}}
+	}
+	return nil
+}
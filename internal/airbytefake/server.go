@@ -0,0 +1,167 @@
+// Package airbytefake implements the parts of the Airbyte Config API the provider uses, backed by
+// an in-memory store, so provider tests can run without a live Airbyte.
+package airbytefake
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Server is an http.Handler serving the fake Config API under /api/v1. Use it with httptest.NewServer.
+type Server struct {
+	// Catalogs are returned by discover_schema, keyed by the docker repository of the source's definition.
+	// Sources of other definitions discover an empty catalog.
+	Catalogs map[string]apiclient.SyncCatalog
+	// CheckConnection decides the outcome of check_connection jobs. All checks succeed when it is nil.
+	CheckConnection func(dockerRepository string, configuration json.RawMessage) (succeeded bool, message string)
+	// Version is reported by instance_configuration. When empty, the endpoint isn't served, as by older Airbyte versions.
+	Version string
+
+	mu                     sync.Mutex
+	mux                    *http.ServeMux
+	workspaces             []*apiclient.Workspace
+	sourceDefinitions      []*apiclient.ConnectorDefinition
+	destinationDefinitions []*apiclient.ConnectorDefinition
+	sources                []*apiclient.Connector
+	destinations           []*apiclient.Connector
+	connections            []*apiclient.Connection
+	operations             []*apiclient.Operation
+	jobs                   int
+}
+
+// New returns a Server holding only the default workspace Airbyte creates on its first launch.
+func New() *Server {
+	s := &Server{
+		Catalogs: map[string]apiclient.SyncCatalog{},
+		mux:      http.NewServeMux(),
+	}
+	s.createWorkspace(apiclient.NewWorkspace{
+		WorkspaceNameBody: apiclient.WorkspaceNameBody{Name: "Default Workspace"},
+	})
+
+	s.handle("health", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, apiclient.HealthCheckResponse{Available: true})
+	})
+	s.handle("instance_configuration", func(w http.ResponseWriter, r *http.Request) {
+		if s.Version == "" {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, apiclient.InstanceConfigurationResponse{Version: s.Version})
+	})
+	s.registerWorkspaces()
+	s.registerConnectorDefinitions()
+	s.registerConnectors()
+	s.registerConnections()
+	s.registerOperations()
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handle(endpoint string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(fmt.Sprintf("/%s/%s", apiclient.BaseUrl, endpoint), handler)
+}
+
+// errorResponse is the body of Airbyte's 404 and 422 responses
+type errorResponse struct {
+	Id                 string                              `json:"id,omitempty"`
+	Message            string                              `json:"message"`
+	ExceptionClassName string                              `json:"exceptionClassName"`
+	ExceptionStack     []string                            `json:"exceptionStack"`
+	ValidationErrors   []apiclient.PropertyValidationError `json:"validationErrors,omitempty"`
+}
+
+func notFound(w http.ResponseWriter, configType string, id string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(errorResponse{
+		Id:                 id,
+		Message:            fmt.Sprintf("Could not find configuration for %s: %s.", configType, id),
+		ExceptionClassName: "io.airbyte.config.persistence.ConfigNotFoundException",
+		ExceptionStack:     []string{},
+	})
+}
+
+func invalid(w http.ResponseWriter, validationErrors ...apiclient.PropertyValidationError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	_ = json.NewEncoder(w).Encode(errorResponse{
+		Message:            "Some properties contained invalid input.",
+		ExceptionClassName: "javax.validation.ConstraintViolationException",
+		ExceptionStack:     []string{},
+		ValidationErrors:   validationErrors,
+	})
+}
+
+func required(propertyPath string) apiclient.PropertyValidationError {
+	return apiclient.PropertyValidationError{PropertyPath: propertyPath, Message: "must not be null"}
+}
+
+// requiredFields returns a violation for each of the named values that is empty
+func requiredFields(prefix string, values [][2]string) []apiclient.PropertyValidationError {
+	var validationErrors []apiclient.PropertyValidationError
+	for _, v := range values {
+		if v[1] == "" {
+			validationErrors = append(validationErrors, required(prefix+v[0]))
+		}
+	}
+	return validationErrors
+}
+
+// decode reads the request body into v, answering with a 422 if it is not valid JSON
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(errorResponse{
+			Message:            fmt.Sprintf("Invalid json input: %s", err),
+			ExceptionClassName: "com.fasterxml.jackson.core.JsonParseException",
+			ExceptionStack:     []string{},
+		})
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// newJob returns the info of a job that ran just now
+func (s *Server) newJob(configType string, succeeded bool, logLines ...string) apiclient.JobInfo {
+	s.jobs++
+	now := time.Now().Unix()
+	return apiclient.JobInfo{
+		Id:         fmt.Sprint(s.jobs),
+		ConfigType: configType,
+		CreatedAt:  now,
+		EndedAt:    now,
+		Succeeded:  succeeded,
+		Logs:       &apiclient.JobLog{LogLines: logLines},
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
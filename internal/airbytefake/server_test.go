@@ -0,0 +1,171 @@
+package airbytefake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T) (*Server, *apiclient.ApiClient) {
+	fake := New()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	return fake, &apiclient.ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newTestClient(t)
+	fake.Catalogs["airbyte/source-test"] = apiclient.SyncCatalog{Streams: []apiclient.Stream{{
+		Stream: apiclient.SourceStreamSchema{Name: "users", SupportedSyncModes: []string{"full_refresh"}},
+		Config: apiclient.DestinationStreamConfig{SyncMode: "full_refresh", DestinationSyncMode: "overwrite"},
+	}}}
+
+	if err := client.Check(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	workspace, err := client.CreateWorkspace(ctx, apiclient.NewWorkspace{WorkspaceNameBody: apiclient.WorkspaceNameBody{Name: "My Workspace"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workspace.Slug != "my-workspace" {
+		t.Fatalf("unexpected slug: %s", workspace.Slug)
+	}
+	bySlug, err := client.GetWorkspaceBySlug(ctx, workspace.Slug)
+	if err != nil || bySlug.WorkspaceId != workspace.WorkspaceId {
+		t.Fatalf("expected to find the workspace by slug, got %v, %v", bySlug, err)
+	}
+
+	definitions := map[apiclient.ConnectorType]*apiclient.ConnectorDefinition{}
+	connectors := map[apiclient.ConnectorType]*apiclient.Connector{}
+	for connectorType, repository := range map[apiclient.ConnectorType]string{apiclient.SourceType: "airbyte/source-test", apiclient.DestinationType: "airbyte/destination-test"} {
+		fields := &apiclient.CommonConnectorDefinitionFields{
+			Name:             repository,
+			DockerRepository: repository,
+			DockerImageTag:   "0.1.0",
+			DocumentationUrl: "https://docs.airbyte.com",
+		}
+		newDefinition := apiclient.NewConnectorDefinition{WorkspaceIdBody: workspace.WorkspaceIdBody, SourceDefinition: fields}
+		if connectorType == apiclient.DestinationType {
+			newDefinition = apiclient.NewConnectorDefinition{WorkspaceIdBody: workspace.WorkspaceIdBody, DestinationDefinition: fields}
+		}
+		definition, err := client.CreateConnectorDefinition(ctx, newDefinition, connectorType)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		definitions[connectorType] = definition
+
+		newConnector := apiclient.NewConnector{
+			SourceDefinitionIdBody:      definition.SourceDefinitionIdBody,
+			DestinationDefinitionIdBody: definition.DestinationDefinitionIdBody,
+			WorkspaceIdBody:             workspace.WorkspaceIdBody,
+			CommonConnectorFields: apiclient.CommonConnectorFields{
+				Name:                    "test",
+				ConnectionConfiguration: json.RawMessage(`{"host": "localhost"}`),
+			},
+		}
+		connector, err := client.CreateConnector(ctx, newConnector, connectorType)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		connectors[connectorType] = connector
+	}
+	if connectors[apiclient.SourceType].SourceName != "airbyte/source-test" {
+		t.Fatalf("expected the definition name to be returned, got %s", connectors[apiclient.SourceType].SourceName)
+	}
+
+	catalog, err := client.GetSourceSchemaCatalogById(ctx, connectors[apiclient.SourceType].SourceId)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	connection, err := client.CreateConnection(ctx, apiclient.NewConnection{
+		CommonConnectionFields: apiclient.CommonConnectionFields{Status: "active", SyncCatalog: &catalog.Catalog},
+		SourceIdBody:           connectors[apiclient.SourceType].SourceIdBody,
+		DestinationIdBody:      connectors[apiclient.DestinationType].DestinationIdBody,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if connection.Name != "test <> test" || connection.ScheduleType != "manual" || connection.Geography != "auto" {
+		t.Fatalf("expected Airbyte's defaults, got %+v", connection)
+	}
+	connections, err := client.ListConnections(ctx, workspace.WorkspaceId)
+	if err != nil || len(connections) != 1 {
+		t.Fatalf("expected to list the connection, got %v, %v", connections, err)
+	}
+
+	// Invalid sync modes are rejected against the stream they are set on
+	catalog.Catalog.Streams[0].Config.SyncMode = "incremental"
+	_, err = client.UpdateConnection(ctx, apiclient.UpdatedConnection{
+		ConnectionIdBody:       connection.ConnectionIdBody,
+		CommonConnectionFields: apiclient.CommonConnectionFields{Status: "active", SyncCatalog: &catalog.Catalog},
+	})
+	var validationErr *apiclient.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.ValidationErrors[0].PropertyPath != "syncCatalog.streams[0].config.syncMode" {
+		t.Fatalf("expected a validation error for the sync mode, got %v", err)
+	}
+
+	if err := client.DeleteConnection(ctx, connection.ConnectionId); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, err = client.GetConnectionById(ctx, connection.ConnectionId)
+	var notFoundErr *apiclient.NotFoundError
+	if !errors.As(err, &notFoundErr) || notFoundErr.Id != connection.ConnectionId {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	_, err = client.CreateWorkspace(ctx, apiclient.NewWorkspace{})
+	if !errors.As(err, &validationErr) || validationErr.ValidationErrors[0].PropertyPath != "name" {
+		t.Fatalf("expected a validation error for the name, got %v", err)
+	}
+}
+
+func TestServerCheckConnection(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newTestClient(t)
+	fake.CheckConnection = func(dockerRepository string, configuration json.RawMessage) (bool, string) {
+		return false, "Could not connect"
+	}
+
+	workspaces, err := client.GetWorkspaces(ctx)
+	if err != nil || len(workspaces) != 1 {
+		t.Fatalf("expected the default workspace, got %v, %v", workspaces, err)
+	}
+	definition, err := client.CreateConnectorDefinition(ctx, apiclient.NewConnectorDefinition{
+		WorkspaceIdBody: workspaces[0].WorkspaceIdBody,
+		SourceDefinition: &apiclient.CommonConnectorDefinitionFields{
+			Name:             "test",
+			DockerRepository: "airbyte/source-test",
+			DockerImageTag:   "0.1.0",
+			DocumentationUrl: "https://docs.airbyte.com",
+		},
+	}, apiclient.SourceType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	checkResponse, err := client.CheckNewConnector(ctx, apiclient.NewConnector{
+		SourceDefinitionIdBody: definition.SourceDefinitionIdBody,
+		WorkspaceIdBody:        workspaces[0].WorkspaceIdBody,
+		CommonConnectorFields: apiclient.CommonConnectorFields{
+			Name:                    "test",
+			ConnectionConfiguration: json.RawMessage(`{}`),
+		},
+	}, apiclient.SourceType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if checkResponse.Status != "failed" || checkResponse.Message != "Could not connect" {
+		t.Fatalf("expected the check to fail, got %+v", checkResponse)
+	}
+}
@@ -0,0 +1,183 @@
+package airbytefake
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+func (s *Server) registerWorkspaces() {
+	s.handle("workspaces/create", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.NewWorkspace{}
+		if !decode(w, r, &body) {
+			return
+		}
+		if body.Name == "" {
+			invalid(w, required("name"))
+			return
+		}
+		writeJSON(w, s.createWorkspace(body))
+	})
+
+	s.handle("workspaces/get", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.WorkspaceIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		workspace := s.workspace(body.WorkspaceId)
+		if workspace == nil {
+			notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+			return
+		}
+		writeJSON(w, workspace)
+	})
+
+	s.handle("workspaces/get_by_slug", func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Slug string `json:"slug"`
+		}{}
+		if !decode(w, r, &body) {
+			return
+		}
+		for _, workspace := range s.workspaces {
+			if workspace.Slug == body.Slug {
+				writeJSON(w, workspace)
+				return
+			}
+		}
+		notFound(w, "STANDARD_WORKSPACE", body.Slug)
+	})
+
+	s.handle("workspaces/list", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, apiclient.WorkspaceList{Workspaces: s.workspaces})
+	})
+
+	s.handle("workspaces/update", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.UpdatedWorkspace{}
+		if !decode(w, r, &body) {
+			return
+		}
+		workspace := s.workspace(body.WorkspaceId)
+		if workspace == nil {
+			notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+			return
+		}
+		if body.Email != "" {
+			workspace.Email = body.Email
+		}
+		if body.AnonymousDataCollection != nil {
+			workspace.AnonymousDataCollection = body.AnonymousDataCollection
+		}
+		if body.News != nil {
+			workspace.News = body.News
+		}
+		if body.SecurityUpdates != nil {
+			workspace.SecurityUpdates = body.SecurityUpdates
+		}
+		if body.DisplaySetupWizard != nil {
+			workspace.DisplaySetupWizard = body.DisplaySetupWizard
+		}
+		if body.DefaultGeography != "" {
+			workspace.DefaultGeography = body.DefaultGeography
+		}
+		workspace.Notifications = withNotificationDefaults(body.Notifications)
+		writeJSON(w, workspace)
+	})
+
+	s.handle("workspaces/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := apiclient.WorkspaceIdBody{}
+		if !decode(w, r, &body) {
+			return
+		}
+		for i, workspace := range s.workspaces {
+			if workspace.WorkspaceId == body.WorkspaceId {
+				s.workspaces = append(s.workspaces[:i], s.workspaces[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		notFound(w, "STANDARD_WORKSPACE", body.WorkspaceId)
+	})
+}
+
+func (s *Server) workspace(id string) *apiclient.Workspace {
+	for _, workspace := range s.workspaces {
+		if workspace.WorkspaceId == id {
+			return workspace
+		}
+	}
+	return nil
+}
+
+func (s *Server) createWorkspace(body apiclient.NewWorkspace) *apiclient.Workspace {
+	workspace := &apiclient.Workspace{
+		WorkspaceIdBody:   apiclient.WorkspaceIdBody{WorkspaceId: newId()},
+		WorkspaceNameBody: body.WorkspaceNameBody,
+		CommonWorkspaceFields: apiclient.CommonWorkspaceFields{
+			Email:                   body.Email,
+			AnonymousDataCollection: boolPtr(false),
+			News:                    boolPtr(false),
+			DisplaySetupWizard:      boolPtr(false),
+			DefaultGeography:        "auto",
+		},
+		CustomerId:           newId(),
+		Slug:                 s.uniqueSlug(body.Name),
+		InitialSetupComplete: boolPtr(false),
+		SecurityUpdates:      boolPtr(false),
+		Notifications:        withNotificationDefaults(body.Notifications),
+	}
+	if body.DefaultGeography != "" {
+		workspace.DefaultGeography = body.DefaultGeography
+	}
+	if body.AnonymousDataCollection != nil {
+		workspace.AnonymousDataCollection = body.AnonymousDataCollection
+	}
+	if body.News != nil {
+		workspace.News = body.News
+	}
+	if body.DisplaySetupWizard != nil {
+		workspace.DisplaySetupWizard = body.DisplaySetupWizard
+	}
+	if body.SecurityUpdates != nil {
+		workspace.SecurityUpdates = body.SecurityUpdates
+	}
+
+	s.workspaces = append(s.workspaces, workspace)
+	return workspace
+}
+
+func (s *Server) uniqueSlug(name string) string {
+	base := slugInvalidChars.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+	slug := base
+	for i := 1; ; i++ {
+		taken := false
+		for _, workspace := range s.workspaces {
+			if workspace.Slug == slug {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			return slug
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func withNotificationDefaults(notifications []apiclient.Notification) []apiclient.Notification {
+	result := []apiclient.Notification{}
+	for _, notification := range notifications {
+		if notification.SendOnSuccess == nil {
+			notification.SendOnSuccess = boolPtr(false)
+		}
+		if notification.SendOnFailure == nil {
+			notification.SendOnFailure = boolPtr(true)
+		}
+		result = append(result, notification)
+	}
+	return result
+}
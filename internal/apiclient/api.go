@@ -0,0 +1,72 @@
+package apiclient
+
+import "context"
+
+// Client is the Airbyte API the provider depends on. ApiClient implements it against the Config
+// API; resources only depend on the part of it they use, so they can be tested with fakes.
+type Client interface {
+	// Check returns an error unless the Airbyte server is available
+	Check(ctx context.Context) error
+	// Capabilities returns what the server supports, as detected by Check
+	Capabilities() Capabilities
+	// ReportsAllAttributes returns whether the objects the client returns have every attribute the
+	// Config API has. The public API leaves some out, e.g. workspace notification settings and the
+	// source schema of streams.
+	ReportsAllAttributes() bool
+
+	WorkspacesAPI
+	ConnectorDefinitionsAPI
+	ConnectorsAPI
+	SourceSchemaCatalogsAPI
+	ConnectionsAPI
+	OperationsAPI
+}
+
+type WorkspacesAPI interface {
+	GetWorkspaceById(ctx context.Context, workspaceId string) (*Workspace, error)
+	GetWorkspaceBySlug(ctx context.Context, slug string) (*Workspace, error)
+	GetWorkspaces(ctx context.Context) ([]*Workspace, error)
+	CreateWorkspace(ctx context.Context, newWorkspace NewWorkspace) (*Workspace, error)
+	UpdateWorkspace(ctx context.Context, updatedWorkspace UpdatedWorkspace) (*Workspace, error)
+	DeleteWorkspace(ctx context.Context, workspaceId string) error
+}
+
+type ConnectorDefinitionsAPI interface {
+	GetConnectorDefinitionById(ctx context.Context, connectorDefinitionId string, t ConnectorType) (*ConnectorDefinition, error)
+	CreateConnectorDefinition(ctx context.Context, newDefinition NewConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error)
+	UpdateConnectorDefinition(ctx context.Context, updatedConnectorDefinition UpdatedConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error)
+	DeleteConnectorDefinition(ctx context.Context, connectorDefinitionId string, t ConnectorType) error
+}
+
+type ConnectorsAPI interface {
+	GetConnectorById(ctx context.Context, connectorId string, t ConnectorType) (*Connector, error)
+	ListConnectors(ctx context.Context, workspaceId string, t ConnectorType) ([]*Connector, error)
+	CreateConnector(ctx context.Context, newConnector NewConnector, t ConnectorType) (*Connector, error)
+	UpdateConnector(ctx context.Context, updatedConnector UpdatedConnector, t ConnectorType) (*Connector, error)
+	DeleteConnector(ctx context.Context, connectorId string, t ConnectorType) error
+	CheckNewConnector(ctx context.Context, connector NewConnector, t ConnectorType) (*CheckConnectionResponse, error)
+	CheckUpdatedConnector(ctx context.Context, connector UpdatedConnector, t ConnectorType) (*CheckConnectionResponse, error)
+	CheckConnector(ctx context.Context, connectorId string, t ConnectorType) (*CheckConnectionResponse, error)
+}
+
+type SourceSchemaCatalogsAPI interface {
+	GetSourceSchemaCatalogById(ctx context.Context, sourceId string) (*SourceSchemaCatalog, error)
+}
+
+type ConnectionsAPI interface {
+	GetConnectionById(ctx context.Context, connectionId string) (*Connection, error)
+	ListConnections(ctx context.Context, workspaceId string) ([]*Connection, error)
+	CreateConnection(ctx context.Context, newConnection NewConnection) (*Connection, error)
+	UpdateConnection(ctx context.Context, updatedConnection UpdatedConnection) (*Connection, error)
+	DeleteConnection(ctx context.Context, connectionId string) error
+}
+
+type OperationsAPI interface {
+	GetOperationById(ctx context.Context, operationId string) (*Operation, error)
+	CheckOperation(ctx context.Context, opCfg OperationConfig) (*OperationCheckResponse, error)
+	CreateOperation(ctx context.Context, newOperation NewOperation) (*Operation, error)
+	UpdateOperation(ctx context.Context, updatedOperation UpdatedOperation) (*Operation, error)
+	DeleteOperation(ctx context.Context, operationId string) error
+}
+
+var _ Client = (*ApiClient)(nil)
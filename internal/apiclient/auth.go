@@ -0,0 +1,144 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator adds credentials to every request sent to the Airbyte API.
+type Authenticator interface {
+	Authenticate(ctx context.Context, c *ApiClient, req *http.Request) error
+}
+
+// BasicAuth authenticates with HTTP Basic auth, as used by Airbyte OSS deployments.
+type BasicAuth struct {
+	Username string
+	Password string
+	// Default is set when the credentials are Airbyte's defaults rather than configured ones. They then
+	// don't replace an Authorization header set otherwise, e.g. by AdditionalHeaders.
+	Default bool
+}
+
+func (a *BasicAuth) Authenticate(ctx context.Context, c *ApiClient, req *http.Request) error {
+	if a.Default && req.Header.Get("Authorization") != "" {
+		return nil
+	}
+	if a.Username != "" && a.Password != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Basic %s", basicAuth(a.Username, a.Password)))
+	}
+	return nil
+}
+
+func basicAuth(username, password string) string {
+	auth := username + ":" + password
+	return base64.StdEncoding.EncodeToString([]byte(auth))
+}
+
+// BearerTokenAuth authenticates with a static bearer token.
+type BearerTokenAuth struct {
+	Token string
+}
+
+func (a *BearerTokenAuth) Authenticate(ctx context.Context, c *ApiClient, req *http.Request) error {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+	return nil
+}
+
+// ClientCredentialsAuth authenticates with an access token issued by Airbyte for an application's
+// client id and secret. The token is fetched on first use and fetched again once it expires.
+type ClientCredentialsAuth struct {
+	ClientId     string
+	ClientSecret string
+	// TokenURL defaults to the applications/token endpoint of the Airbyte API
+	TokenURL string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+type applicationTokenRequest struct {
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	GrantType    string `json:"grant_type"`
+}
+
+type ApplicationToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// tokenExpiryMargin is how long before its expiry a token gets replaced, so it doesn't expire mid-request
+const tokenExpiryMargin = 30 * time.Second
+
+func (a *ClientCredentialsAuth) Authenticate(ctx context.Context, c *ApiClient, req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken == "" || (!a.expiresAt.IsZero() && time.Now().After(a.expiresAt)) {
+		token, err := c.requestApplicationToken(ctx, a.tokenURL(c), a.ClientId, a.ClientSecret)
+		if err != nil {
+			return fmt.Errorf("requesting access token failed: %w", err)
+		}
+		a.accessToken = token.AccessToken
+		a.expiresAt = time.Time{}
+		if token.ExpiresIn > 0 {
+			lifetime := time.Duration(token.ExpiresIn) * time.Second
+			margin := tokenExpiryMargin
+			if margin > lifetime/2 {
+				margin = lifetime / 2
+			}
+			a.expiresAt = time.Now().Add(lifetime - margin)
+		}
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.accessToken))
+	return nil
+}
+
+func (a *ClientCredentialsAuth) tokenURL(c *ApiClient) string {
+	if a.TokenURL != "" {
+		return a.TokenURL
+	}
+	return fmt.Sprintf("%s/%s/applications/token", c.HostURL, BaseUrl)
+}
+
+func (c *ApiClient) requestApplicationToken(ctx context.Context, tokenURL string, clientId string, clientSecret string) (*ApplicationToken, error) {
+	rb, err := json.Marshal(applicationTokenRequest{
+		ClientId:     clientId,
+		ClientSecret: clientSecret,
+		GrantType:    "client_credentials",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+	body, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := ApplicationToken{}
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("no access_token in response from %s", tokenURL)
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,68 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientCredentialsAuth(t *testing.T) {
+	tokensIssued := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/applications/token":
+			body := applicationTokenRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.ClientId != "id" || body.ClientSecret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			tokensIssued++
+			fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 600}`, tokensIssued)
+		case "/api/v1/health":
+			if r.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", tokensIssued) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"available": true}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	auth := &ClientCredentialsAuth{ClientId: "id", ClientSecret: "secret"}
+	client := ApiClient{
+		HostURL:    server.URL,
+		Auth:       auth,
+		HTTPClient: retryablehttp.NewClient(),
+	}
+
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if tokensIssued != 1 {
+		t.Fatalf("expected the token to be reused, got %d tokens issued", tokensIssued)
+	}
+
+	auth.expiresAt = time.Now().Add(-time.Second)
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if tokensIssued != 2 {
+		t.Fatalf("expected expired token to be replaced, got %d tokens issued", tokensIssued)
+	}
+
+	auth.ClientSecret = "wrong"
+	auth.accessToken = ""
+	if err := client.Check(context.Background()); err == nil {
+		t.Fatalf("expected error for invalid client credentials")
+	}
+}
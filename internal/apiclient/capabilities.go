@@ -0,0 +1,118 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Capability is a part of the Config API that only exists from some Airbyte version on
+type Capability struct {
+	// Name is how the capability is referred to in diagnostics
+	Name string
+	// MinVersion is the first Airbyte version offering it
+	MinVersion string
+}
+
+// The capabilities of each part of the Config API that changed between versions, with the first
+// release whose API spec has it
+var (
+	// CapabilityGeography covers the geography of connections and the defaultGeography of workspaces
+	CapabilityGeography = Capability{Name: "geography", MinVersion: "0.40.14"}
+	// CapabilityWebhookOperations covers operations with operatorType webhook
+	CapabilityWebhookOperations = Capability{Name: "webhook operations", MinVersion: "0.40.7"}
+	// CapabilityBreakingChange covers the breakingChange of connections
+	CapabilityBreakingChange = Capability{Name: "breakingChange", MinVersion: "0.40.19"}
+	// CapabilityNonBreakingChangesPreference covers the nonBreakingChangesPreference of connections
+	CapabilityNonBreakingChangesPreference = Capability{Name: "nonBreakingChangesPreference", MinVersion: "0.40.19"}
+	// CapabilityNotificationSettings covers the notificationSettings of workspaces and connections
+	CapabilityNotificationSettings = Capability{Name: "notificationSettings", MinVersion: "0.50.0"}
+)
+
+// Capabilities tells which capabilities the Airbyte server supports, based on its version
+type Capabilities struct {
+	// Version is the version the server reported, empty if it couldn't be determined
+	Version string
+}
+
+// Supports reports whether the server offers capability. Servers whose version is unknown, such as
+// development builds, are assumed to support everything.
+func (c Capabilities) Supports(capability Capability) bool {
+	version, ok := parseVersion(c.Version)
+	if !ok {
+		return true
+	}
+	minVersion, ok := parseVersion(capability.MinVersion)
+	if !ok {
+		return true
+	}
+	for i := range version {
+		if version[i] != minVersion[i] {
+			return version[i] > minVersion[i]
+		}
+	}
+	return true
+}
+
+// parseVersion parses a version such as 0.40.18 or v0.40.18-alpha into its major, minor and patch numbers
+func parseVersion(v string) ([3]int, bool) {
+	var parsed [3]int
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) != len(parsed) {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
+
+type InstanceConfigurationResponse struct {
+	Version string `json:"version"`
+}
+
+func (c *ApiClient) Capabilities() Capabilities {
+	return c.capabilities
+}
+
+func (c *ApiClient) ReportsAllAttributes() bool {
+	return true
+}
+
+// detectCapabilities asks the server for its version. Older servers don't serve their instance
+// configuration, or leave the version out of it, in which case the version stays unknown.
+func (c *ApiClient) detectCapabilities(ctx context.Context) Capabilities {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/%s/instance_configuration", c.HostURL, BaseUrl), nil)
+	if err != nil {
+		return Capabilities{}
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		tflog.Debug(ctx, "Could not detect the Airbyte version", map[string]interface{}{"error": err.Error()})
+		return Capabilities{}
+	}
+
+	res := InstanceConfigurationResponse{}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		tflog.Debug(ctx, "Could not detect the Airbyte version", map[string]interface{}{"error": err.Error()})
+		return Capabilities{}
+	}
+
+	tflog.Debug(ctx, "Detected the Airbyte version", map[string]interface{}{"version": res.Version})
+	return Capabilities{Version: res.Version}
+}
@@ -0,0 +1,69 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCapabilitiesSupports(t *testing.T) {
+	capability := Capability{Name: "test", MinVersion: "0.40.18"}
+	for version, expected := range map[string]bool{
+		"":              true,
+		"dev":           true,
+		"0.40.18":       true,
+		"0.40.22":       true,
+		"v0.41.0-alpha": true,
+		"1.0.0":         true,
+		"0.40.17":       false,
+		"0.39.41-alpha": false,
+	} {
+		if actual := (Capabilities{Version: version}).Supports(capability); actual != expected {
+			t.Errorf("version %q: expected %t, got %t", version, expected, actual)
+		}
+	}
+}
+
+func TestCheckDetectsVersion(t *testing.T) {
+	version := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/health":
+			fmt.Fprint(w, `{"available": true}`)
+		case "/api/v1/instance_configuration":
+			if version == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(w, `{"edition": "community", "version": %q}`, version)
+		}
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+
+	// Older servers don't serve their instance configuration, which doesn't fail the check
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v := client.Capabilities().Version; v != "" {
+		t.Fatalf("expected an unknown version, got %q", v)
+	}
+
+	version = "0.40.4"
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v := client.Capabilities().Version; v != version {
+		t.Fatalf("expected version %q, got %q", version, v)
+	}
+	if client.Capabilities().Supports(CapabilityWebhookOperations) {
+		t.Fatalf("expected %s to be unsupported by %s", CapabilityWebhookOperations.Name, version)
+	}
+}
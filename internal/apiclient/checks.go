@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,13 +9,29 @@ import (
 )
 
 type JobInfo struct {
-	Succeeded  bool   `json:"succeeded"`
-	Id         string `json:"id"`
-	configType string
-	configId   string
-	createdAt  int
-	endedAt    int
-	logs       map[string][]string
+	Succeeded  bool    `json:"succeeded"`
+	Id         string  `json:"id"`
+	ConfigType string  `json:"configType"`
+	ConfigId   string  `json:"configId,omitempty"`
+	CreatedAt  int64   `json:"createdAt"`
+	EndedAt    int64   `json:"endedAt"`
+	Logs       *JobLog `json:"logs,omitempty"`
+}
+
+type JobLog struct {
+	LogLines []string `json:"logLines"`
+}
+
+// LogTail returns the last n lines of the job's log, which Airbyte only returns for some jobs
+func (j JobInfo) LogTail(n int) []string {
+	if j.Logs == nil {
+		return nil
+	}
+	lines := j.Logs.LogLines
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
 }
 
 type CheckConnectionResponse struct {
@@ -23,7 +40,7 @@ type CheckConnectionResponse struct {
 	JobInfo JobInfo `json:"jobInfo"`
 }
 
-func (c *ApiClient) CheckNewConnector(connector NewConnector, t ConnectorType) (*CheckConnectionResponse, error) {
+func (c *ApiClient) CheckNewConnector(ctx context.Context, connector NewConnector, t ConnectorType) (*CheckConnectionResponse, error) {
 	// This API endpoint takes everything except the name, which it'll yell about, so we do this
 	connectorToCheck := connector
 	connectorToCheck.Name = ""
@@ -41,11 +58,17 @@ func (c *ApiClient) CheckNewConnector(connector NewConnector, t ConnectorType) (
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/scheduler/%s/check_connection", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/scheduler/%s/check_connection", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
+	release, err := c.startJob(ctx, "check_connection")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
@@ -60,7 +83,7 @@ func (c *ApiClient) CheckNewConnector(connector NewConnector, t ConnectorType) (
 	return &res, nil
 }
 
-func (c *ApiClient) CheckUpdatedConnector(connector UpdatedConnector, t ConnectorType) (*CheckConnectionResponse, error) {
+func (c *ApiClient) CheckUpdatedConnector(ctx context.Context, connector UpdatedConnector, t ConnectorType) (*CheckConnectionResponse, error) {
 	rb, err := json.Marshal(connector)
 	var urlPath string
 	if t == SourceType {
@@ -74,10 +97,60 @@ func (c *ApiClient) CheckUpdatedConnector(connector UpdatedConnector, t Connecto
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/check_connection_for_update", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/check_connection_for_update", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.startJob(ctx, "check_connection_for_update")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := CheckConnectionResponse{}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *ApiClient) CheckConnector(ctx context.Context, connectorId string, t ConnectorType) (*CheckConnectionResponse, error) {
+	var (
+		rb      []byte
+		err     error
+		urlPath string
+	)
+	if t == SourceType {
+		rb, err = json.Marshal(SourceIdBody{SourceId: connectorId})
+		urlPath = "sources"
+	} else if t == DestinationType {
+		rb, err = json.Marshal(DestinationIdBody{DestinationId: connectorId})
+		urlPath = "destinations"
+	} else {
+		err = fmt.Errorf("invalid ConnectorType: %d", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/check_connection", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.startJob(ctx, "check_connection")
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	body, err := c.doRequest(req)
 	if err != nil {
@@ -0,0 +1,53 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCheckNewConnectorJobInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"status": "failed",
+			"message": "Could not connect",
+			"jobInfo": {
+				"id": "a4e8c2b0-1d2f-4b7e-9c3a-5f6e7d8c9b0a",
+				"configType": "check_connection_source",
+				"configId": "d1",
+				"createdAt": 1666000000,
+				"endedAt": 1666000012,
+				"succeeded": true,
+				"logs": {"logLines": ["one", "two", "three"]}
+			}
+		}`)
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := &ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+
+	res, err := client.CheckNewConnector(context.Background(), NewConnector{}, SourceType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	job := res.JobInfo
+	if job.ConfigType != "check_connection_source" || job.ConfigId != "d1" || job.CreatedAt != 1666000000 || job.EndedAt != 1666000012 {
+		t.Fatalf("expected the job info to be decoded, got %+v", job)
+	}
+	if tail := job.LogTail(2); !reflect.DeepEqual(tail, []string{"two", "three"}) {
+		t.Fatalf("expected the last two log lines, got %v", tail)
+	}
+	if tail := job.LogTail(10); len(tail) != 3 {
+		t.Fatalf("expected all log lines, got %v", tail)
+	}
+	if tail := (JobInfo{}).LogTail(10); tail != nil {
+		t.Fatalf("expected no log lines without logs, got %v", tail)
+	}
+}
@@ -1,24 +1,35 @@
 package apiclient
 
 import (
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptrace"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const BaseUrl = "api/v1"
 
 type ApiClient struct {
 	HostURL           string // http://localhost:8000
-	Username          string
-	Password          string
+	Auth              Authenticator
 	HTTPClient        *retryablehttp.Client
 	AdditionalHeaders map[string]string
+	// RecoverCreates makes create calls that fail with a transport error look for the object the
+	// request may have created anyway, and return it if exactly one matches.
+	RecoverCreates bool
+	// Jobs limits how many check and discover jobs run at once when set
+	Jobs *JobLimiter
+
+	// capabilities is detected from the server version by Check
+	capabilities Capabilities
 }
 
 type HealthCheckResponse struct {
@@ -33,10 +44,10 @@ type CommonErrorResponseFields struct {
 
 type Response422 struct {
 	CommonErrorResponseFields
-	ValidationErrors []ValidationError `json:"validationErrors"`
+	ValidationErrors []PropertyValidationError `json:"validationErrors"`
 }
 
-type ValidationError struct {
+type PropertyValidationError struct {
 	PropertyPath string `json:"propertyPath"`
 	InvalidValue string `json:"invalidValue"`
 	Message      string `json:"message"`
@@ -53,8 +64,8 @@ type Response500 struct {
 	CommonErrorResponseFields
 }
 
-func (c *ApiClient) Check() error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/health", c.HostURL, BaseUrl), nil)
+func (c *ApiClient) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/%s/health", c.HostURL, BaseUrl), nil)
 	if err != nil {
 		return err
 	}
@@ -74,19 +85,27 @@ func (c *ApiClient) Check() error {
 		return fmt.Errorf("url: %s, available: %t, body: %s", req.URL, hcr.Available, body)
 	}
 
+	c.capabilities = c.detectCapabilities(ctx)
+
 	return nil
 }
 
-func basicAuth(username, password string) string {
-	auth := username + ":" + password
-	return base64.StdEncoding.EncodeToString([]byte(auth))
+func (c *ApiClient) doRequest(req *http.Request) ([]byte, error) {
+	c.setHeaders(req)
+	// Credentials are added last, so they replace static headers of the same name
+	if c.Auth != nil {
+		err := c.Auth.Authenticate(req.Context(), c, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.send(req)
 }
 
-func (c *ApiClient) doRequest(req *http.Request) ([]byte, error) {
+// setHeaders sets the static headers of every request on req
+func (c *ApiClient) setHeaders(req *http.Request) {
 	req.Header.Set("Content-Type", "application/json")
-	if c.Username != "" && c.Password != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Basic %s", basicAuth(c.Username, c.Password)))
-	}
 	for k, v := range c.AdditionalHeaders {
 		if strings.ToLower(k) == "host" {
 			req.Host = v
@@ -94,14 +113,55 @@ func (c *ApiClient) doRequest(req *http.Request) ([]byte, error) {
 			req.Header.Set(k, v)
 		}
 	}
+}
+
+// send performs req as it is, without adding any headers to it
+func (c *ApiClient) send(req *http.Request) ([]byte, error) {
+	ctx, retries := withLogging(req.Context())
+	if isCreateRequest(req) {
+		ctx = withNonIdempotent(ctx)
+	}
+	var sent int32
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				atomic.StoreInt32(&sent, 1)
+			}
+		},
+	})
+	secret := c.secretHeaders()
+	ctx = withSecretHeaders(ctx, secret)
+	req = req.WithContext(ctx)
+
+	var reqBody []byte
+	if req.GetBody != nil {
+		if b, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(b)
+		}
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Sending Airbyte API request", map[string]interface{}{
+		"method":   req.Method,
+		"endpoint": req.URL.Path,
+		"headers":  redactHeaders(req.Header, secret),
+		"body":     redactBody(reqBody),
+	})
 
 	retryableReq, err := retryablehttp.FromRequest(req)
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	res, err := c.HTTPClient.Do(retryableReq)
+	logFields := map[string]interface{}{
+		"method":      req.Method,
+		"endpoint":    req.URL.Path,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"retries":     *retries,
+	}
 	if err != nil {
-		return nil, err
+		logFields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystem, "Airbyte API request failed", logFields)
+		return nil, &TransportError{Err: err, Sent: atomic.LoadInt32(&sent) == 1}
 	}
 	defer res.Body.Close()
 
@@ -110,33 +170,45 @@ func (c *ApiClient) doRequest(req *http.Request) ([]byte, error) {
 		return nil, err
 	}
 
+	logFields["status"] = res.StatusCode
+	tflog.SubsystemDebug(ctx, logSubsystem, "Airbyte API request", logFields)
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received Airbyte API response", map[string]interface{}{
+		"method":   req.Method,
+		"endpoint": req.URL.Path,
+		"status":   res.StatusCode,
+		"headers":  redactHeaders(res.Header, secret),
+		"body":     redactBody(body),
+	})
+
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		apiErr := ApiError{
+			URL:        req.URL.String(),
+			StatusCode: res.StatusCode,
+			Body:       body,
+		}
 		if res.StatusCode == http.StatusUnprocessableEntity {
 			r := Response422{}
 			err := json.Unmarshal(body, &r)
-			fmt.Println(err)
 			if err == nil {
-				body, _ = json.Marshal(r)
+				apiErr.Body, _ = json.Marshal(r)
 			}
+			return nil, &ValidationError{ApiError: apiErr, Response422: r}
 		} else if res.StatusCode == http.StatusNotFound {
 			r := Response404{}
 			err := json.Unmarshal(body, &r)
 			if err == nil {
-				body, _ = json.Marshal(r)
+				apiErr.Body, _ = json.Marshal(r)
 			}
+			return nil, &NotFoundError{ApiError: apiErr, Response404: r}
 		} else if res.StatusCode == http.StatusInternalServerError {
 			r := Response500{}
 			err := json.Unmarshal(body, &r)
 			if err == nil {
-				body, _ = json.Marshal(r)
+				apiErr.Body, _ = json.Marshal(r)
 			}
+			return nil, &ServerError{ApiError: apiErr, Response500: r}
 		}
-		return nil, fmt.Errorf(
-			"url: %s, status: %d, body: %s",
-			req.URL,
-			res.StatusCode,
-			body,
-		)
+		return nil, &apiErr
 	}
 
 	return body, err
@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -25,6 +26,10 @@ type NewConnection struct {
 	DestinationIdBody
 }
 
+type ConnectionList struct {
+	Connections []*Connection `json:"connections"`
+}
+
 type UpdatedConnection struct {
 	ConnectionIdBody
 	CommonConnectionFields
@@ -64,7 +69,7 @@ type CronScheduleSpec struct {
 	CronTimeZone   string `json:"cronTimeZone"`
 }
 
-func (c *ApiClient) GetConnectionById(connectionId string) (*Connection, error) {
+func (c *ApiClient) GetConnectionById(ctx context.Context, connectionId string) (*Connection, error) {
 	rb, err := json.Marshal(ConnectionIdBody{
 		ConnectionId: connectionId,
 	})
@@ -72,7 +77,7 @@ func (c *ApiClient) GetConnectionById(connectionId string) (*Connection, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/connections/get", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/connections/get", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -91,19 +96,49 @@ func (c *ApiClient) GetConnectionById(connectionId string) (*Connection, error)
 	return &connection, nil
 }
 
-func (c *ApiClient) CreateConnection(newConnection NewConnection) (*Connection, error) {
+func (c *ApiClient) ListConnections(ctx context.Context, workspaceId string) ([]*Connection, error) {
+	rb, err := json.Marshal(WorkspaceIdBody{WorkspaceId: workspaceId})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/connections/list", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := ConnectionList{}
+	err = json.Unmarshal(body, &cl)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.Connections, nil
+}
+
+func (c *ApiClient) CreateConnection(ctx context.Context, newConnection NewConnection) (*Connection, error) {
 	rb, err := json.Marshal(newConnection)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/connections/create", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/connections/create", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
 	body, err := c.doRequest(req)
 	if err != nil {
+		if c.RecoverCreates && IsTransportError(err) {
+			if connection := c.findCreatedConnection(ctx, newConnection); connection != nil {
+				return connection, nil
+			}
+		}
 		return nil, err
 	}
 
@@ -116,13 +151,13 @@ func (c *ApiClient) CreateConnection(newConnection NewConnection) (*Connection,
 	return &connection, nil
 }
 
-func (c *ApiClient) UpdateConnection(updatedConnection UpdatedConnection) (*Connection, error) {
+func (c *ApiClient) UpdateConnection(ctx context.Context, updatedConnection UpdatedConnection) (*Connection, error) {
 	rb, err := json.Marshal(updatedConnection)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/connections/update", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/connections/update", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -141,13 +176,13 @@ func (c *ApiClient) UpdateConnection(updatedConnection UpdatedConnection) (*Conn
 	return &connection, nil
 }
 
-func (c *ApiClient) DeleteConnection(connectionId string) error {
+func (c *ApiClient) DeleteConnection(ctx context.Context, connectionId string) error {
 	rb, err := json.Marshal(ConnectionIdBody{ConnectionId: connectionId})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/connections/delete", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/connections/delete", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
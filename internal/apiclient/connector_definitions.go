@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -69,7 +70,7 @@ const (
 	DestinationType
 )
 
-func (c *ApiClient) GetConnectorDefinitionById(connectorDefinitionId string, t ConnectorType) (*ConnectorDefinition, error) {
+func (c *ApiClient) GetConnectorDefinitionById(ctx context.Context, connectorDefinitionId string, t ConnectorType) (*ConnectorDefinition, error) {
 	var (
 		rb      []byte
 		err     error
@@ -88,7 +89,7 @@ func (c *ApiClient) GetConnectorDefinitionById(connectorDefinitionId string, t C
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/get", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/get", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +108,7 @@ func (c *ApiClient) GetConnectorDefinitionById(connectorDefinitionId string, t C
 	return &dd, nil
 }
 
-func (c *ApiClient) CreateConnectorDefinition(newDefinition NewConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error) {
+func (c *ApiClient) CreateConnectorDefinition(ctx context.Context, newDefinition NewConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error) {
 	rb, err := json.Marshal(newDefinition)
 	var urlPath string
 	if t == SourceType {
@@ -121,7 +122,7 @@ func (c *ApiClient) CreateConnectorDefinition(newDefinition NewConnectorDefiniti
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/create_custom", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/create_custom", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +141,7 @@ func (c *ApiClient) CreateConnectorDefinition(newDefinition NewConnectorDefiniti
 	return &dd, nil
 }
 
-func (c *ApiClient) UpdateConnectorDefinition(updatedConnectorDefinition UpdatedConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error) {
+func (c *ApiClient) UpdateConnectorDefinition(ctx context.Context, updatedConnectorDefinition UpdatedConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error) {
 	rb, err := json.Marshal(updatedConnectorDefinition)
 	var urlPath string
 	if t == SourceType {
@@ -154,7 +155,7 @@ func (c *ApiClient) UpdateConnectorDefinition(updatedConnectorDefinition Updated
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/update", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/update", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +174,7 @@ func (c *ApiClient) UpdateConnectorDefinition(updatedConnectorDefinition Updated
 	return &dd, nil
 }
 
-func (c *ApiClient) DeleteConnectorDefinition(connectorDefinitionId string, t ConnectorType) error {
+func (c *ApiClient) DeleteConnectorDefinition(ctx context.Context, connectorDefinitionId string, t ConnectorType) error {
 	var (
 		rb      []byte
 		err     error
@@ -192,7 +193,7 @@ func (c *ApiClient) DeleteConnectorDefinition(connectorDefinitionId string, t Co
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/delete", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/delete", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -38,13 +39,18 @@ type NewConnector struct {
 	CommonConnectorFields
 }
 
+type ConnectorList struct {
+	Sources      []*Connector `json:"sources"`
+	Destinations []*Connector `json:"destinations"`
+}
+
 type UpdatedConnector struct {
 	SourceIdBody
 	DestinationIdBody
 	CommonConnectorFields
 }
 
-func (c *ApiClient) GetConnectorById(connectorId string, t ConnectorType) (*Connector, error) {
+func (c *ApiClient) GetConnectorById(ctx context.Context, connectorId string, t ConnectorType) (*Connector, error) {
 	var (
 		rb      []byte
 		err     error
@@ -63,7 +69,7 @@ func (c *ApiClient) GetConnectorById(connectorId string, t ConnectorType) (*Conn
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/get", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/get", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -82,8 +88,8 @@ func (c *ApiClient) GetConnectorById(connectorId string, t ConnectorType) (*Conn
 	return &s, nil
 }
 
-func (c *ApiClient) CreateConnector(newConnector NewConnector, t ConnectorType) (*Connector, error) {
-	rb, err := json.Marshal(newConnector)
+func (c *ApiClient) ListConnectors(ctx context.Context, workspaceId string, t ConnectorType) ([]*Connector, error) {
+	rb, err := json.Marshal(WorkspaceIdBody{WorkspaceId: workspaceId})
 	var urlPath string
 	if t == SourceType {
 		urlPath = "sources"
@@ -96,21 +102,54 @@ func (c *ApiClient) CreateConnector(newConnector NewConnector, t ConnectorType)
 		return nil, err
 	}
 
-	checkResponse, err := c.CheckNewConnector(newConnector, t)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/list", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
-	if checkResponse.Status != "succeeded" {
-		return nil, fmt.Errorf("checking new configuration failed (jobId: %s, message: %s)", checkResponse.JobInfo.Id, checkResponse.Message)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/create", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	cl := ConnectorList{}
+	err = json.Unmarshal(body, &cl)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == SourceType {
+		return cl.Sources, nil
+	}
+	return cl.Destinations, nil
+}
+
+func (c *ApiClient) CreateConnector(ctx context.Context, newConnector NewConnector, t ConnectorType) (*Connector, error) {
+	rb, err := json.Marshal(newConnector)
+	var urlPath string
+	if t == SourceType {
+		urlPath = "sources"
+	} else if t == DestinationType {
+		urlPath = "destinations"
+	} else {
+		err = fmt.Errorf("invalid ConnectorType: %d", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/create", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
 	body, err := c.doRequest(req)
 	if err != nil {
+		if c.RecoverCreates && IsTransportError(err) {
+			if s := c.findCreatedConnector(ctx, newConnector, t); s != nil {
+				return s, nil
+			}
+		}
 		return nil, err
 	}
 
@@ -123,7 +162,7 @@ func (c *ApiClient) CreateConnector(newConnector NewConnector, t ConnectorType)
 	return &s, nil
 }
 
-func (c *ApiClient) UpdateConnector(updatedConnector UpdatedConnector, t ConnectorType) (*Connector, error) {
+func (c *ApiClient) UpdateConnector(ctx context.Context, updatedConnector UpdatedConnector, t ConnectorType) (*Connector, error) {
 	rb, err := json.Marshal(updatedConnector)
 	var urlPath string
 	if t == SourceType {
@@ -137,15 +176,7 @@ func (c *ApiClient) UpdateConnector(updatedConnector UpdatedConnector, t Connect
 		return nil, err
 	}
 
-	checkResponse, err := c.CheckUpdatedConnector(updatedConnector, t)
-	if err != nil {
-		return nil, err
-	}
-	if checkResponse.Status != "succeeded" {
-		return nil, fmt.Errorf("checking updated configuration failed (jobId: %s, message: %s)", checkResponse.JobInfo.Id, checkResponse.Message)
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/update", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/update", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +195,7 @@ func (c *ApiClient) UpdateConnector(updatedConnector UpdatedConnector, t Connect
 	return &s, nil
 }
 
-func (c *ApiClient) DeleteConnector(connectorId string, t ConnectorType) error {
+func (c *ApiClient) DeleteConnector(ctx context.Context, connectorId string, t ConnectorType) error {
 	var (
 		rb      []byte
 		err     error
@@ -183,7 +214,7 @@ func (c *ApiClient) DeleteConnector(connectorId string, t ConnectorType) error {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/%s/delete", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/%s/delete", c.HostURL, BaseUrl, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
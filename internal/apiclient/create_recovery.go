@@ -0,0 +1,94 @@
+package apiclient
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// findCreatedConnector returns the only connector in the workspace matching newConnector, or nil if
+// there is no single match or listing fails.
+func (c *ApiClient) findCreatedConnector(ctx context.Context, newConnector NewConnector, t ConnectorType) *Connector {
+	connectors, err := c.ListConnectors(ctx, newConnector.WorkspaceId, t)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list connectors to recover a failed create", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	var matches []*Connector
+	for _, connector := range connectors {
+		if connector.Name == newConnector.Name &&
+			connector.SourceDefinitionId == newConnector.SourceDefinitionId &&
+			connector.DestinationDefinitionId == newConnector.DestinationDefinitionId {
+			matches = append(matches, connector)
+		}
+	}
+	if len(matches) != 1 {
+		return nil
+	}
+
+	tflog.Warn(ctx, "Adopting connector created by a failed create request", map[string]interface{}{
+		"name":          newConnector.Name,
+		"sourceId":      matches[0].SourceId,
+		"destinationId": matches[0].DestinationId,
+	})
+	return matches[0]
+}
+
+// findCreatedConnection returns the only connection between the planned source and destination
+// matching newConnection, or nil if there is no single match or listing fails.
+func (c *ApiClient) findCreatedConnection(ctx context.Context, newConnection NewConnection) *Connection {
+	// Connections are listed per workspace, which the create request does not name
+	source, err := c.GetConnectorById(ctx, newConnection.SourceId, SourceType)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read source to recover a failed create", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+	connections, err := c.ListConnections(ctx, source.WorkspaceId)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list connections to recover a failed create", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	var matches []*Connection
+	for _, connection := range connections {
+		// Airbyte generates a name if none is given, so only compare names when one was planned
+		if connection.SourceId == newConnection.SourceId &&
+			connection.DestinationId == newConnection.DestinationId &&
+			(newConnection.Name == "" || connection.Name == newConnection.Name) {
+			matches = append(matches, connection)
+		}
+	}
+	if len(matches) != 1 {
+		return nil
+	}
+
+	tflog.Warn(ctx, "Adopting connection created by a failed create request", map[string]interface{}{
+		"connectionId": matches[0].ConnectionId,
+	})
+	return matches[0]
+}
+
+// findCreatedWorkspace returns the only workspace named like newWorkspace, or nil if there is no
+// single match or listing fails.
+func (c *ApiClient) findCreatedWorkspace(ctx context.Context, newWorkspace NewWorkspace) *Workspace {
+	workspaces, err := c.GetWorkspaces(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list workspaces to recover a failed create", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	var matches []*Workspace
+	for _, workspace := range workspaces {
+		if workspace.Name == newWorkspace.Name {
+			matches = append(matches, workspace)
+		}
+	}
+	if len(matches) != 1 {
+		return nil
+	}
+
+	tflog.Warn(ctx, "Adopting workspace created by a failed create request", map[string]interface{}{
+		"workspaceId": matches[0].WorkspaceId,
+	})
+	return matches[0]
+}
@@ -0,0 +1,100 @@
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateWorkspaceRecovery(t *testing.T) {
+	workspaces := `{"workspaces": [{"workspaceId": "other", "name": "other"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/workspaces/create":
+			// Airbyte creates the workspace but the response arrives after the client gave up
+			workspaces = `{"workspaces": [{"workspaceId": "other", "name": "other"}, {"workspaceId": "created", "name": "test"}]}`
+			time.Sleep(200 * time.Millisecond)
+		case "/api/v1/workspaces/list":
+			fmt.Fprint(w, workspaces)
+		}
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient.Timeout = 50 * time.Millisecond
+	httpClient.RetryMax = 0
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+	newWorkspace := NewWorkspace{WorkspaceNameBody: WorkspaceNameBody{Name: "test"}}
+
+	_, err := client.CreateWorkspace(context.Background(), newWorkspace)
+	if !IsTransportError(err) {
+		t.Fatalf("expected a transport error without recovery, got %v", err)
+	}
+
+	client.RecoverCreates = true
+	workspace, err := client.CreateWorkspace(context.Background(), newWorkspace)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workspace.WorkspaceId != "created" {
+		t.Fatalf("expected the created workspace to be adopted, got %s", workspace.WorkspaceId)
+	}
+
+	newWorkspace.Name = "other-test"
+	if _, err := client.CreateWorkspace(context.Background(), newWorkspace); err == nil {
+		t.Fatalf("expected the error to be returned when no workspace matches")
+	}
+}
+
+// createDialFailingTransport sends create requests to a closed port, so they fail before reaching Airbyte
+type createDialFailingTransport struct {
+	closedHost string
+}
+
+func (t createDialFailingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, "/create") {
+		req = req.Clone(req.Context())
+		req.URL.Host = t.closedHost
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestCreateRecoveryDialError(t *testing.T) {
+	listed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A workspace of the planned name exists already, e.g. in another stack
+		listed = true
+		fmt.Fprint(w, `{"workspaces": [{"workspaceId": "existing", "name": "test"}]}`)
+	}))
+	defer server.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closedHost := closed.Listener.Addr().String()
+	closed.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient.Transport = createDialFailingTransport{closedHost: closedHost}
+	httpClient.RetryMax = 0
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, HTTPClient: httpClient, RecoverCreates: true}
+
+	workspace, err := client.CreateWorkspace(context.Background(), NewWorkspace{WorkspaceNameBody: WorkspaceNameBody{Name: "test"}})
+	if err == nil || IsTransportError(err) {
+		t.Fatalf("expected a connection error, got %v, %v", workspace, err)
+	}
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) || transportErr.Sent {
+		t.Fatalf("expected a TransportError of a request that wasn't sent, got %#v", err)
+	}
+	if listed {
+		t.Fatalf("expected no recovery for a request that never reached Airbyte")
+	}
+}
@@ -0,0 +1,69 @@
+package apiclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ApiError is returned by doRequest for any non-2xx response from the Airbyte API.
+type ApiError struct {
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("url: %s, status: %d, body: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// NotFoundError is returned when the requested object does not exist (HTTP 404).
+type NotFoundError struct {
+	ApiError
+	Response404
+}
+
+// ValidationError is returned when Airbyte rejects the request body (HTTP 422).
+type ValidationError struct {
+	ApiError
+	Response422
+}
+
+// ServerError is returned when Airbyte fails to handle the request (HTTP 500).
+type ServerError struct {
+	ApiError
+	Response500
+}
+
+// UnsupportedError is returned for operations the API a client talks to doesn't offer.
+type UnsupportedError struct {
+	Operation string
+	API       string
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s is not supported by the %s", e.Operation, e.API)
+}
+
+// TransportError is returned when a request failed without a response from Airbyte.
+type TransportError struct {
+	Err error
+	// Sent is set if the request was written to the connection before it failed, e.g. because the
+	// response timed out or the connection was reset. It isn't if it failed to connect at all, on
+	// DNS, TLS or proxy errors, so Airbyte can't have handled it.
+	Sent bool
+}
+
+func (e *TransportError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// IsTransportError reports whether err means the request was sent to Airbyte but failed without a
+// response, e.g. because it timed out, so the request may or may not have been handled.
+func IsTransportError(err error) bool {
+	var transportErr *TransportError
+	return errors.As(err, &transportErr) && transportErr.Sent
+}
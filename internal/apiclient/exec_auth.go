@@ -0,0 +1,102 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExecAuth authenticates with credentials printed by a local command, similar to kubectl's exec
+// credential plugins. The command is run again once the credentials it printed expire.
+type ExecAuth struct {
+	Command string
+	Args    []string
+	Env     map[string]string
+
+	mu          sync.Mutex
+	credentials *ExecCredentials
+}
+
+// ExecCredentials is the JSON document an ExecAuth command must print on stdout
+type ExecCredentials struct {
+	// Token is sent as a bearer token when set
+	Token string `json:"token,omitempty"`
+	// Headers are set on every request
+	Headers map[string]string `json:"headers,omitempty"`
+	// ExpirationTimestamp is an RFC 3339 timestamp, the credentials are used for the whole run when unset
+	ExpirationTimestamp *time.Time `json:"expiration_timestamp,omitempty"`
+}
+
+func (a *ExecAuth) Authenticate(ctx context.Context, c *ApiClient, req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.credentials == nil || (a.credentials.ExpirationTimestamp != nil && time.Now().After(*a.credentials.ExpirationTimestamp)) {
+		credentials, err := a.run(ctx)
+		if err != nil {
+			return err
+		}
+		a.credentials = credentials
+	}
+
+	if a.credentials.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.credentials.Token))
+	}
+	for k, v := range a.credentials.Headers {
+		if strings.ToLower(k) == "host" {
+			req.Host = v
+		} else {
+			req.Header.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+func (a *ExecAuth) HeaderNames() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.credentials == nil {
+		return nil
+	}
+	names := make([]string, 0, len(a.credentials.Headers))
+	for k := range a.credentials.Headers {
+		names = append(names, k)
+	}
+	return names
+}
+
+func (a *ExecAuth) run(ctx context.Context) (*ExecCredentials, error) {
+	cmd := exec.CommandContext(ctx, a.Command, a.Args...)
+	cmd.Env = os.Environ()
+	for k, v := range a.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("running credential helper %s failed: %w, stderr: %s", a.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	credentials := ExecCredentials{}
+	err = json.Unmarshal(stdout.Bytes(), &credentials)
+	if err != nil {
+		return nil, fmt.Errorf("decoding output of credential helper %s failed: %w", a.Command, err)
+	}
+	if credentials.Token == "" && len(credentials.Headers) == 0 {
+		return nil, fmt.Errorf("credential helper %s returned neither a token nor headers", a.Command)
+	}
+
+	return &credentials, nil
+}
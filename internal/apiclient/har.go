@@ -0,0 +1,310 @@
+package apiclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// HARRecorder records the requests an ApiClient makes and the responses it gets into a HAR 1.2 file,
+// with secrets masked the same way they are in logs. It records at the transport, see Transport, so
+// every attempt of a request, retries included, gets an entry. Entries are appended to the file if it
+// already holds a HAR log, so the plan and apply runs of the provider end up in the same file.
+type HARRecorder struct {
+	Path    string
+	Creator string
+	Version string
+
+	mu sync.Mutex
+}
+
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	// Attempt is the attempt of the request the entry is for, starting at 1
+	Attempt int `json:"_attempt,omitempty"`
+	// Error is set when no response was received
+	Error string `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// Transport returns a RoundTripper sending requests through base and recording each into the HAR file
+func (h *HARRecorder) Transport(base http.RoundTripper) http.RoundTripper {
+	return &harTransport{base: base, recorder: h}
+}
+
+type harTransport struct {
+	base     http.RoundTripper
+	recorder *HARRecorder
+}
+
+func (t *harTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.GetBody != nil {
+		if b, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(b)
+		}
+	}
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.recorder.record(req, reqBody, start, nil, nil, err)
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	t.recorder.record(req, reqBody, start, res, resBody, err)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// record adds an entry for req to the HAR file. res is nil and err set when no response was received.
+// The values of the headers secretHeadersOf the request's context are masked.
+func (h *HARRecorder) record(req *http.Request, reqBody []byte, start time.Time, res *http.Response, resBody []byte, err error) {
+	elapsed := float64(time.Since(start).Microseconds()) / 1000
+	secret := secretHeadersOf(req.Context())
+
+	entry := harEntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Time:            elapsed,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: req.Proto,
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(req.Header, secret),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTimings{Wait: elapsed},
+	}
+	if retries, ok := req.Context().Value(retryCounterKey{}).(*int); ok {
+		entry.Attempt = *retries + 1
+	}
+	for k, v := range req.URL.Query() {
+		for _, value := range v {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{Name: k, Value: value})
+		}
+	}
+	if len(reqBody) > 0 {
+		entry.Request.PostData = &harPostData{MimeType: req.Header.Get("Content-Type"), Text: redactBody(reqBody)}
+	}
+	if res != nil {
+		entry.Response.Status = res.StatusCode
+		entry.Response.StatusText = http.StatusText(res.StatusCode)
+		entry.Response.HTTPVersion = res.Proto
+		entry.Response.Headers = harHeaders(res.Header, secret)
+		entry.Response.BodySize = len(resBody)
+		entry.Response.Content = harContent{
+			Size:     len(resBody),
+			MimeType: res.Header.Get("Content-Type"),
+			Text:     redactBody(resBody),
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := h.write(entry); err != nil {
+		tflog.SubsystemWarn(req.Context(), logSubsystem, "Unable to write HAR file", map[string]interface{}{
+			"path":  h.Path,
+			"error": err.Error(),
+		})
+	}
+}
+
+// harTrailer closes the entries and the log of the HAR files written by a HARRecorder
+const harTrailer = "\n    ]\n  }\n}\n"
+
+// write appends entry to the HAR file. The file is locked while it is written, as the provider
+// processes of aliased provider configurations record into the same file. Entries are written over
+// the trailer closing the log, followed by it again, so earlier entries aren't written again.
+func (h *HARRecorder) write(entry harEntry) error {
+	f, err := os.OpenFile(h.Path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := lockFile(f); err != nil {
+		return err
+	}
+	defer unlockFile(f)
+
+	offset, empty, err := h.trailerOffset(f)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(entry, "      ", "  ")
+	if err != nil {
+		return err
+	}
+	separator := "\n      "
+	if !empty {
+		separator = "," + separator
+	}
+	data := append([]byte(separator), b...)
+	_, err = f.WriteAt(append(data, harTrailer...), offset)
+	return err
+}
+
+// trailerOffset returns where the trailer of the log in f is, and whether the log has no entries yet.
+// An empty f is initialized with a log, and a HAR log not written by a HARRecorder is rewritten the
+// way it writes them. Files not holding a HAR log are left as they are.
+func (h *HARRecorder) trailerOffset(f *os.File) (int64, bool, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return 0, false, err
+	}
+	size := info.Size()
+	if size > int64(len(harTrailer)) {
+		tail := make([]byte, len(harTrailer)+1)
+		if _, err := f.ReadAt(tail, size-int64(len(tail))); err != nil {
+			return 0, false, err
+		}
+		if bytes.HasSuffix(tail, []byte(harTrailer)) {
+			return size - int64(len(harTrailer)), tail[0] == '[', nil
+		}
+	}
+
+	var entries []harEntry
+	if size > 0 {
+		existing, err := io.ReadAll(f)
+		if err != nil {
+			return 0, false, err
+		}
+		previous := harFile{}
+		if err := json.Unmarshal(existing, &previous); err != nil || previous.Log.Version == "" {
+			return 0, false, fmt.Errorf("%s exists and is not a HAR file", h.Path)
+		}
+		entries = previous.Log.Entries
+	}
+
+	// The log is written without its entries, which are then added like new ones
+	b, err := json.MarshalIndent(harFile{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: h.Creator, Version: h.Version},
+		Entries: []harEntry{},
+	}}, "", "  ")
+	if err != nil {
+		return 0, false, err
+	}
+	empty := "[]\n  }\n}"
+	if !bytes.HasSuffix(b, []byte(empty)) {
+		return 0, false, errors.New("unexpected HAR encoding")
+	}
+	b = append(b[:len(b)-len(empty)], '[')
+	for i, entry := range entries {
+		e, err := json.MarshalIndent(entry, "      ", "  ")
+		if err != nil {
+			return 0, false, err
+		}
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = append(append(b, "\n      "...), e...)
+	}
+
+	if err := f.Truncate(0); err != nil {
+		return 0, false, err
+	}
+	if _, err := f.WriteAt(append(b, harTrailer...), 0); err != nil {
+		return 0, false, err
+	}
+	return int64(len(b)), len(entries) == 0, nil
+}
+
+func harHeaders(h http.Header, secret map[string]bool) []harNameValue {
+	headers := []harNameValue{}
+	for k, v := range redactHeaders(h, secret) {
+		headers = append(headers, harNameValue{Name: k, Value: v})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
@@ -0,0 +1,184 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHARRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"available": true}`)
+	}))
+	defer server.Close()
+
+	harPath := filepath.Join(t.TempDir(), "airbyte.har")
+	newClient := func() ApiClient {
+		recorder := &HARRecorder{Path: harPath, Creator: "test", Version: "dev"}
+		httpClient := retryablehttp.NewClient()
+		httpClient.HTTPClient = &http.Client{Transport: recorder.Transport(http.DefaultTransport)}
+		httpClient.Logger = nil
+		return ApiClient{
+			HostURL:    server.URL,
+			Auth:       &BearerTokenAuth{Token: "secret-token"},
+			HTTPClient: httpClient,
+		}
+	}
+
+	client := newClient()
+	for i := 0; i < 2; i++ {
+		if err := client.Check(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	// A new client, like the one of the next provider run, appends to the file
+	client = newClient()
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(harPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(string(b), "secret-token") {
+		t.Fatalf("expected the token to be masked, got: %s", b)
+	}
+
+	har := harFile{}
+	if err := json.Unmarshal(b, &har); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Each check requests the health and then the instance configuration
+	if har.Log.Version != "1.2" || len(har.Log.Entries) != 6 {
+		t.Fatalf("expected a HAR 1.2 log with 6 entries, got version %s with %d entries", har.Log.Version, len(har.Log.Entries))
+	}
+	entry := har.Log.Entries[0]
+	if entry.Request.URL != server.URL+"/api/v1/health" || entry.Response.Status != http.StatusOK || entry.Response.Content.Text != `{"available":true}` {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+}
+
+func TestHARRecorderRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/health" {
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		}
+		fmt.Fprint(w, `{"available": true}`)
+	}))
+	defer server.Close()
+
+	harPath := filepath.Join(t.TempDir(), "airbyte.har")
+	recorder := &HARRecorder{Path: harPath, Creator: "test", Version: "dev"}
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{Transport: recorder.Transport(http.DefaultTransport)}
+	httpClient.RetryMax = 1
+	httpClient.RetryWaitMin = time.Millisecond
+	httpClient.RetryWaitMax = time.Millisecond
+	httpClient.RequestLogHook = RetryLogHook
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(harPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	har := harFile{}
+	if err := json.Unmarshal(b, &har); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The retried health request gets an entry per attempt
+	if len(har.Log.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(har.Log.Entries))
+	}
+	for i, status := range []int{http.StatusServiceUnavailable, http.StatusOK} {
+		entry := har.Log.Entries[i]
+		if entry.Request.URL != server.URL+"/api/v1/health" || entry.Response.Status != status || entry.Attempt != i+1 {
+			t.Fatalf("expected attempt %d with status %d, got %+v", i+1, status, entry)
+		}
+	}
+}
+
+func TestHARRecorderConcurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"available": true}`)
+	}))
+	defer server.Close()
+
+	// Like the provider processes of aliased provider configurations, each recorder appends to the file
+	harPath := filepath.Join(t.TempDir(), "airbyte.har")
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		client := &http.Client{Transport: (&HARRecorder{Path: harPath}).Transport(http.DefaultTransport)}
+		for j := 0; j < 10; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := client.Get(server.URL)
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+					return
+				}
+				res.Body.Close()
+			}()
+		}
+	}
+	wg.Wait()
+
+	b, err := os.ReadFile(harPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	har := harFile{}
+	if err := json.Unmarshal(b, &har); err != nil {
+		t.Fatalf("expected a valid HAR file, got %s: %s", err, b)
+	}
+	if len(har.Log.Entries) != 20 {
+		t.Fatalf("expected 20 entries, got %d", len(har.Log.Entries))
+	}
+}
+
+func TestHARRecorderKeepsOtherFiles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"available": true}`)
+	}))
+	defer server.Close()
+
+	harPath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	if err := os.WriteFile(harPath, []byte(`{"version": 4}`), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	recorder := &HARRecorder{Path: harPath}
+	if err := recorder.write(harEntry{}); err == nil || !strings.Contains(err.Error(), "not a HAR file") {
+		t.Fatalf("expected an error, got: %v", err)
+	}
+
+	// Requests still go through
+	client := &http.Client{Transport: recorder.Transport(http.DefaultTransport)}
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+	if b, _ := os.ReadFile(harPath); string(b) != `{"version": 4}` {
+		t.Fatalf("expected the file to be left as it is, got: %s", b)
+	}
+}
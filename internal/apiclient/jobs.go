@@ -0,0 +1,57 @@
+package apiclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// JobLimiter caps how many Airbyte jobs, i.e. connection checks and schema discoveries, are in flight
+// at once. Each of those launches a connector on the Airbyte workers, so running many in parallel
+// can overwhelm a small deployment.
+type JobLimiter struct {
+	slots chan struct{}
+}
+
+// NewJobLimiter returns a JobLimiter allowing max jobs at once
+func NewJobLimiter(max int) *JobLimiter {
+	return &JobLimiter{slots: make(chan struct{}, max)}
+}
+
+// acquire waits for a free slot, and returns the function releasing it
+func (l *JobLimiter) acquire(ctx context.Context, job string) (func(), error) {
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	default:
+	}
+
+	start := time.Now()
+	tflog.Debug(ctx, "Waiting for a running Airbyte job to finish", map[string]interface{}{
+		"job":          job,
+		"max_parallel": cap(l.slots),
+	})
+	select {
+	case l.slots <- struct{}{}:
+		tflog.Debug(ctx, "Starting Airbyte job", map[string]interface{}{
+			"job":     job,
+			"wait_ms": time.Since(start).Milliseconds(),
+		})
+		return l.release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *JobLimiter) release() {
+	<-l.slots
+}
+
+// startJob acquires a slot of the client's JobLimiter for job, if it has one
+func (c *ApiClient) startJob(ctx context.Context, job string) (func(), error) {
+	if c.Jobs == nil {
+		return func() {}, nil
+	}
+	return c.Jobs.acquire(ctx, job)
+}
@@ -0,0 +1,75 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestJobLimiter(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `{"status": "succeeded", "jobInfo": {"succeeded": true}, "catalog": {"streams": []}}`)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := &ApiClient{HostURL: server.URL, HTTPClient: httpClient, Jobs: NewJobLimiter(2)}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 6)
+	for i := 0; i < 3; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := client.CheckNewConnector(context.Background(), NewConnector{}, SourceType)
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.GetSourceSchemaCatalogById(context.Background(), "s")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if maxRunning != 2 {
+		t.Fatalf("expected 2 jobs to run at once, got %d", maxRunning)
+	}
+
+	// A job waiting for a slot gives up with its context
+	release, _ := client.startJob(context.Background(), "test")
+	release2, _ := client.startJob(context.Background(), "test")
+	defer release()
+	defer release2()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetSourceSchemaCatalogById(ctx, "s"); err != context.DeadlineExceeded {
+		t.Fatalf("expected the context's error, got: %v", err)
+	}
+}
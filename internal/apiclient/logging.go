@@ -0,0 +1,140 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"strings"
+)
+
+// logSubsystem is the tflog subsystem API calls are logged in. Its level can be set separately
+// with the TF_LOG_PROVIDER_AIRBYTE_API environment variable.
+const logSubsystem = "airbyte_api"
+
+// redactedValue replaces secrets in logs, the same way Airbyte masks secrets it returns.
+const redactedValue = "**********"
+
+// secretHeaders are always masked when headers are logged, see ApiClient.secretHeaders for the others
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+}
+
+// secretBodyKeys are masked wherever they occur in a JSON body. connectionConfiguration is masked as
+// a whole, as which of its fields the connector's spec marks airbyte_secret isn't known here.
+var secretBodyKeys = map[string]bool{
+	"connectionConfiguration": true,
+	"client_secret":           true,
+	"access_token":            true,
+	"token":                   true,
+	"password":                true,
+}
+
+type retryCounterKey struct{}
+
+// withLogging returns ctx with the airbyte_api subsystem logger and a counter RetryLogHook records
+// the retries of the request made with it in.
+func withLogging(ctx context.Context) (context.Context, *int) {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_AIRBYTE_API"), tflog.WithRootFields())
+	retries := new(int)
+	return context.WithValue(ctx, retryCounterKey{}, retries), retries
+}
+
+func recordRetry(ctx context.Context, attemptNum int) {
+	if retries, ok := ctx.Value(retryCounterKey{}).(*int); ok {
+		*retries = attemptNum
+	}
+}
+
+// headerReporter is implemented by Authenticators setting headers of their own, besides Authorization
+type headerReporter interface {
+	// HeaderNames returns the names of the headers set by the last Authenticate
+	HeaderNames() []string
+}
+
+// secretHeaders returns the canonical names of the headers masked in the logs of c's requests: those
+// of secretHeaders, the AdditionalHeaders, which often carry tokens, and those set by c.Auth.
+func (c *ApiClient) secretHeaders() map[string]bool {
+	secret := make(map[string]bool, len(secretHeaders)+len(c.AdditionalHeaders))
+	for k := range secretHeaders {
+		secret[k] = true
+	}
+	for k := range c.AdditionalHeaders {
+		secret[http.CanonicalHeaderKey(k)] = true
+	}
+	if reporter, ok := c.Auth.(headerReporter); ok {
+		for _, k := range reporter.HeaderNames() {
+			secret[http.CanonicalHeaderKey(k)] = true
+		}
+	}
+	return secret
+}
+
+type secretHeadersKey struct{}
+
+// withSecretHeaders returns ctx with the headers masked in the records of the request made with it
+func withSecretHeaders(ctx context.Context, secret map[string]bool) context.Context {
+	return context.WithValue(ctx, secretHeadersKey{}, secret)
+}
+
+// secretHeadersOf returns the headers masked in the records of the request made with ctx, those of
+// secretHeaders if ctx doesn't hold any.
+func secretHeadersOf(ctx context.Context) map[string]bool {
+	if secret, ok := ctx.Value(secretHeadersKey{}).(map[string]bool); ok {
+		return secret
+	}
+	return secretHeaders
+}
+
+// redactHeaders returns h with the values of the headers in secret, canonical names, masked. The
+// auth scheme of Authorization headers is kept, e.g. "Bearer **********".
+func redactHeaders(h http.Header, secret map[string]bool) map[string]string {
+	redacted := make(map[string]string, len(h))
+	for k, v := range h {
+		value := strings.Join(v, ", ")
+		if secret[http.CanonicalHeaderKey(k)] {
+			if scheme, _, found := strings.Cut(value, " "); found && strings.HasSuffix(http.CanonicalHeaderKey(k), "Authorization") {
+				value = scheme + " " + redactedValue
+			} else {
+				value = redactedValue
+			}
+		}
+		redacted[k] = value
+	}
+	return redacted
+}
+
+// redactBody returns body with secrets masked. Bodies that aren't JSON are returned as they are.
+func redactBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if secretBodyKeys[k] && value != nil {
+				v[k] = redactedValue
+			} else {
+				v[k] = redactJSON(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
@@ -0,0 +1,111 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRequestLogging(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_AIRBYTE_API", "TRACE")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sourceId": "s", "name": "pg", "connectionConfiguration": {"password": "hunter2"}}`)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := ApiClient{
+		HostURL:    server.URL,
+		Auth:       &BasicAuth{Username: "airbyte", Password: "hunter2"},
+		HTTPClient: retryablehttp.NewClient(),
+	}
+	client.HTTPClient.Logger = nil
+	if _, err := client.GetConnectorById(ctx, "s", SourceType); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if strings.Contains(output.String(), "hunter2") || strings.Contains(output.String(), basicAuth("airbyte", "hunter2")) {
+		t.Fatalf("expected secrets to be masked, got: %s", output.String())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var found bool
+	for _, entry := range entries {
+		if entry["@message"] == "Airbyte API request" {
+			found = true
+			if entry["@module"] != "provider.airbyte_api" || entry["endpoint"] != "/api/v1/sources/get" ||
+				entry["status"] != float64(200) || entry["retries"] != float64(0) || entry["duration_ms"] == nil {
+				t.Fatalf("unexpected log entry: %v", entry)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected the request to be logged, got: %v", entries)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	got := redactBody([]byte(`{"items": [{"client_secret": "s", "name": "n"}], "connectionConfiguration": {"host": "h"}}`))
+	want := `{"connectionConfiguration":"**********","items":[{"client_secret":"**********","name":"n"}]}`
+	if got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+	if got := redactBody([]byte("not json")); got != "not json" {
+		t.Fatalf("expected non JSON bodies to be kept, got %s", got)
+	}
+}
+
+func TestRequestLoggingMasksCustomHeaders(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_AIRBYTE_API", "TRACE")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"available": true}`)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	harPath := filepath.Join(t.TempDir(), "airbyte.har")
+	client := ApiClient{
+		HostURL: server.URL,
+		Auth: &ExecAuth{
+			Command: "sh",
+			Args:    []string{"-c", `printf '{"headers": {"X-Session": "exec-session"}}'`},
+		},
+		AdditionalHeaders: map[string]string{"X-Gateway-Token": "static-token"},
+		HTTPClient:        retryablehttp.NewClient(),
+	}
+	client.HTTPClient.HTTPClient = &http.Client{Transport: (&HARRecorder{Path: harPath}).Transport(http.DefaultTransport)}
+	client.HTTPClient.Logger = nil
+	if err := client.Check(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	har, err := os.ReadFile(harPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, secret := range []string{"exec-session", "static-token"} {
+		if strings.Contains(output.String(), secret) || strings.Contains(string(har), secret) {
+			t.Fatalf("expected %s to be masked, got logs: %s\nHAR: %s", secret, output.String(), har)
+		}
+	}
+	if !strings.Contains(string(har), "X-Session") || !strings.Contains(string(har), "X-Gateway-Token") {
+		t.Fatalf("expected the masked headers to be recorded, got: %s", har)
+	}
+}
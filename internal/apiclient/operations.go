@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -61,7 +62,7 @@ type OperationCheckResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
-func (c *ApiClient) GetOperationById(operationId string) (*Operation, error) {
+func (c *ApiClient) GetOperationById(ctx context.Context, operationId string) (*Operation, error) {
 	rb, err := json.Marshal(OperationIdBody{
 		OperationId: operationId,
 	})
@@ -69,7 +70,7 @@ func (c *ApiClient) GetOperationById(operationId string) (*Operation, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/operations/get", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/operations/get", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -88,13 +89,13 @@ func (c *ApiClient) GetOperationById(operationId string) (*Operation, error) {
 	return &operation, nil
 }
 
-func (c *ApiClient) CheckOperation(opCfg OperationConfig) (*OperationCheckResponse, error) {
+func (c *ApiClient) CheckOperation(ctx context.Context, opCfg OperationConfig) (*OperationCheckResponse, error) {
 	rb, err := json.Marshal(opCfg)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/operations/check", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/operations/check", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -113,13 +114,13 @@ func (c *ApiClient) CheckOperation(opCfg OperationConfig) (*OperationCheckRespon
 	return &check, nil
 }
 
-func (c *ApiClient) CreateOperation(newOperation NewOperation) (*Operation, error) {
+func (c *ApiClient) CreateOperation(ctx context.Context, newOperation NewOperation) (*Operation, error) {
 	rb, err := json.Marshal(newOperation)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/operations/create", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/operations/create", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -138,13 +139,13 @@ func (c *ApiClient) CreateOperation(newOperation NewOperation) (*Operation, erro
 	return &operation, nil
 }
 
-func (c *ApiClient) UpdateOperation(updatedOperation UpdatedOperation) (*Operation, error) {
+func (c *ApiClient) UpdateOperation(ctx context.Context, updatedOperation UpdatedOperation) (*Operation, error) {
 	rb, err := json.Marshal(updatedOperation)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/operations/update", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/operations/update", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -163,13 +164,13 @@ func (c *ApiClient) UpdateOperation(updatedOperation UpdatedOperation) (*Operati
 	return &operation, nil
 }
 
-func (c *ApiClient) DeleteOperation(operationId string) error {
+func (c *ApiClient) DeleteOperation(ctx context.Context, operationId string) error {
 	rb, err := json.Marshal(OperationIdBody{OperationId: operationId})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/operations/delete", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/operations/delete", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
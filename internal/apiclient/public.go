@@ -0,0 +1,174 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const PublicBaseUrl = "v1"
+
+// publicPageSize is the number of objects requested per page when listing through the public API
+const publicPageSize = 100
+
+// PublicApiClient implements Client against Airbyte's public API, as served by Airbyte Cloud at
+// https://api.airbyte.com and by Airbyte OSS under /api/public. Requests are sent through the ApiClient
+// it wraps, so they share its authentication, retries and logging.
+//
+// Objects are converted to and from the Config API types the provider works with. Attributes the
+// public API doesn't expose, such as workspace notification settings or the JSON schema of streams,
+// are left empty in the objects it returns and ignored in the ones it sends. Operations it doesn't
+// offer at all return an UnsupportedError.
+type PublicApiClient struct {
+	api *ApiClient
+}
+
+func NewPublicApiClient(api *ApiClient) *PublicApiClient {
+	return &PublicApiClient{api: api}
+}
+
+var _ Client = (*PublicApiClient)(nil)
+
+func (c *PublicApiClient) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.url("health"), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.api.doRequest(req)
+	return err
+}
+
+// Capabilities returns an unknown version, as the public API doesn't report the server's
+func (c *PublicApiClient) Capabilities() Capabilities {
+	return Capabilities{}
+}
+
+func (c *PublicApiClient) ReportsAllAttributes() bool {
+	return false
+}
+
+func (c *PublicApiClient) url(path string) string {
+	return fmt.Sprintf("%s/%s/%s", c.api.HostURL, PublicBaseUrl, path)
+}
+
+// do sends a request with v as its JSON body, if not nil, and decodes the response into out, if not nil
+func (c *PublicApiClient) do(ctx context.Context, method string, path string, v interface{}, out interface{}) error {
+	var body io.Reader
+	if v != nil {
+		rb, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(rb)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url(path), body)
+	if err != nil {
+		return err
+	}
+
+	resBody, err := c.api.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(resBody, out)
+}
+
+// create is do for requests that create objects, which must not be retried
+func (c *PublicApiClient) create(ctx context.Context, path string, v interface{}, out interface{}) error {
+	return c.do(withNonIdempotent(ctx), "POST", path, v, out)
+}
+
+// publicPage is the envelope the public API lists objects in
+type publicPage[T any] struct {
+	Data []T `json:"data"`
+}
+
+// list fetches every page of the objects at path in the given workspace
+func list[T any](ctx context.Context, c *PublicApiClient, path string, workspaceId string) ([]T, error) {
+	var all []T
+	for offset := 0; ; offset += publicPageSize {
+		query := url.Values{}
+		if workspaceId != "" {
+			query.Set("workspaceIds", workspaceId)
+		}
+		query.Set("limit", strconv.Itoa(publicPageSize))
+		query.Set("offset", strconv.Itoa(offset))
+
+		page := publicPage[T]{}
+		err := c.do(ctx, "GET", path+"?"+query.Encode(), nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Data...)
+		if len(page.Data) < publicPageSize {
+			return all, nil
+		}
+	}
+}
+
+func unsupported(operation string) error {
+	return &UnsupportedError{Operation: operation, API: "Airbyte public API"}
+}
+
+func (c *PublicApiClient) GetConnectorDefinitionById(ctx context.Context, connectorDefinitionId string, t ConnectorType) (*ConnectorDefinition, error) {
+	return nil, unsupported("reading connector definitions")
+}
+
+func (c *PublicApiClient) CreateConnectorDefinition(ctx context.Context, newDefinition NewConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error) {
+	return nil, unsupported("creating connector definitions")
+}
+
+func (c *PublicApiClient) UpdateConnectorDefinition(ctx context.Context, updatedConnectorDefinition UpdatedConnectorDefinition, t ConnectorType) (*ConnectorDefinition, error) {
+	return nil, unsupported("updating connector definitions")
+}
+
+func (c *PublicApiClient) DeleteConnectorDefinition(ctx context.Context, connectorDefinitionId string, t ConnectorType) error {
+	return unsupported("deleting connector definitions")
+}
+
+func (c *PublicApiClient) CheckNewConnector(ctx context.Context, connector NewConnector, t ConnectorType) (*CheckConnectionResponse, error) {
+	return nil, unsupported("checking connectors")
+}
+
+func (c *PublicApiClient) CheckUpdatedConnector(ctx context.Context, connector UpdatedConnector, t ConnectorType) (*CheckConnectionResponse, error) {
+	return nil, unsupported("checking connectors")
+}
+
+func (c *PublicApiClient) CheckConnector(ctx context.Context, connectorId string, t ConnectorType) (*CheckConnectionResponse, error) {
+	return nil, unsupported("checking connectors")
+}
+
+func (c *PublicApiClient) GetSourceSchemaCatalogById(ctx context.Context, sourceId string) (*SourceSchemaCatalog, error) {
+	return nil, unsupported("discovering source schemas")
+}
+
+func (c *PublicApiClient) GetOperationById(ctx context.Context, operationId string) (*Operation, error) {
+	return nil, unsupported("reading operations")
+}
+
+func (c *PublicApiClient) CheckOperation(ctx context.Context, opCfg OperationConfig) (*OperationCheckResponse, error) {
+	return nil, unsupported("checking operations")
+}
+
+func (c *PublicApiClient) CreateOperation(ctx context.Context, newOperation NewOperation) (*Operation, error) {
+	return nil, unsupported("creating operations")
+}
+
+func (c *PublicApiClient) UpdateOperation(ctx context.Context, updatedOperation UpdatedOperation) (*Operation, error) {
+	return nil, unsupported("updating operations")
+}
+
+func (c *PublicApiClient) DeleteOperation(ctx context.Context, operationId string) error {
+	return unsupported("deleting operations")
+}
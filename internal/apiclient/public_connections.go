@@ -0,0 +1,218 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type publicConnection struct {
+	ConnectionId        string                      `json:"connectionId,omitempty"`
+	Name                string                      `json:"name,omitempty"`
+	SourceId            string                      `json:"sourceId,omitempty"`
+	DestinationId       string                      `json:"destinationId,omitempty"`
+	WorkspaceId         string                      `json:"workspaceId,omitempty"`
+	Status              string                      `json:"status,omitempty"`
+	Schedule            *publicConnectionSchedule   `json:"schedule,omitempty"`
+	DataResidency       string                      `json:"dataResidency,omitempty"`
+	NamespaceDefinition string                      `json:"namespaceDefinition,omitempty"`
+	NamespaceFormat     string                      `json:"namespaceFormat,omitempty"`
+	Prefix              string                      `json:"prefix,omitempty"`
+	Configurations      *publicStreamConfigurations `json:"configurations,omitempty"`
+}
+
+type publicConnectionSchedule struct {
+	ScheduleType   string `json:"scheduleType"`
+	CronExpression string `json:"cronExpression,omitempty"`
+	// BasicTiming describes basic schedules, e.g. "Every 24 hours". It can't be set through the public API.
+	BasicTiming string `json:"basicTiming,omitempty"`
+}
+
+type publicStreamConfigurations struct {
+	Streams []publicStreamConfiguration `json:"streams"`
+}
+
+type publicStreamConfiguration struct {
+	Name string `json:"name"`
+	// SyncMode combines the sync mode and destination sync mode of the Config API, e.g. incremental_append
+	SyncMode    string     `json:"syncMode,omitempty"`
+	CursorField []string   `json:"cursorField,omitempty"`
+	PrimaryKey  [][]string `json:"primaryKey,omitempty"`
+}
+
+// publicSyncModes maps the sync mode and destination sync mode of the Config API to the public API's sync mode
+var publicSyncModes = map[[2]string]string{
+	{"full_refresh", "overwrite"}:   "full_refresh_overwrite",
+	{"full_refresh", "append"}:      "full_refresh_append",
+	{"incremental", "append"}:       "incremental_append",
+	{"incremental", "append_dedup"}: "incremental_deduped_history",
+}
+
+var basicTimingPattern = regexp.MustCompile(`^Every (\d+) (\w+)$`)
+
+func (p publicConnection) toConnection() *Connection {
+	connection := Connection{
+		ConnectionIdBody:  ConnectionIdBody{ConnectionId: p.ConnectionId},
+		SourceIdBody:      SourceIdBody{SourceId: p.SourceId},
+		DestinationIdBody: DestinationIdBody{DestinationId: p.DestinationId},
+		CommonConnectionFields: CommonConnectionFields{
+			Status:              p.Status,
+			Name:                p.Name,
+			NamespaceDefinition: strings.ReplaceAll(p.NamespaceDefinition, "_", ""),
+			NamespaceFormat:     p.NamespaceFormat,
+			Prefix:              p.Prefix,
+		},
+		Geography: p.DataResidency,
+	}
+
+	if p.Schedule != nil {
+		connection.ScheduleType = p.Schedule.ScheduleType
+		if p.Schedule.CronExpression != "" {
+			// Cron expressions of the public API are evaluated in UTC
+			connection.ScheduleData = &ScheduleData{Cron: &CronScheduleSpec{
+				CronExpression: p.Schedule.CronExpression,
+				CronTimeZone:   "UTC",
+			}}
+		} else if m := basicTimingPattern.FindStringSubmatch(p.Schedule.BasicTiming); m != nil {
+			units, _ := strconv.ParseInt(m[1], 10, 64)
+			connection.ScheduleData = &ScheduleData{BasicSchedule: &ScheduleSpec{
+				Units:    units,
+				TimeUnit: strings.ToLower(m[2]),
+			}}
+		}
+	}
+
+	if p.Configurations != nil {
+		connection.SyncCatalog = &SyncCatalog{Streams: []Stream{}}
+		for _, s := range p.Configurations.Streams {
+			// The public API only returns the streams that are synced
+			selected := true
+			stream := Stream{
+				Stream: SourceStreamSchema{Name: s.Name},
+				Config: DestinationStreamConfig{
+					SyncMode:    s.SyncMode,
+					CursorField: s.CursorField,
+					PrimaryKey:  s.PrimaryKey,
+					Selected:    &selected,
+				},
+			}
+			for modes, syncMode := range publicSyncModes {
+				if syncMode == s.SyncMode {
+					stream.Config.SyncMode = modes[0]
+					stream.Config.DestinationSyncMode = modes[1]
+				}
+			}
+			connection.SyncCatalog.Streams = append(connection.SyncCatalog.Streams, stream)
+		}
+	}
+
+	return &connection
+}
+
+// toPublicConnection converts the settings of fields that the public API has to a publicConnection
+func toPublicConnection(fields CommonConnectionFields) (publicConnection, error) {
+	p := publicConnection{
+		Name:            fields.Name,
+		Status:          fields.Status,
+		NamespaceFormat: fields.NamespaceFormat,
+		Prefix:          fields.Prefix,
+	}
+	if fields.NamespaceDefinition == "customformat" {
+		p.NamespaceDefinition = "custom_format"
+	} else {
+		p.NamespaceDefinition = fields.NamespaceDefinition
+	}
+
+	switch fields.ScheduleType {
+	case "":
+	case "basic":
+		return p, unsupported("setting basic schedules")
+	case "cron":
+		p.Schedule = &publicConnectionSchedule{ScheduleType: "cron"}
+		if fields.ScheduleData != nil && fields.ScheduleData.Cron != nil {
+			p.Schedule.CronExpression = fields.ScheduleData.Cron.CronExpression
+		}
+	default:
+		p.Schedule = &publicConnectionSchedule{ScheduleType: fields.ScheduleType}
+	}
+
+	if fields.SyncCatalog != nil {
+		p.Configurations = &publicStreamConfigurations{Streams: []publicStreamConfiguration{}}
+		for _, s := range fields.SyncCatalog.Streams {
+			syncMode, ok := publicSyncModes[[2]string{s.Config.SyncMode, s.Config.DestinationSyncMode}]
+			if !ok {
+				return p, fmt.Errorf("stream %s: sync mode %s with destination sync mode %s is not supported by the Airbyte public API",
+					s.Stream.Name, s.Config.SyncMode, s.Config.DestinationSyncMode)
+			}
+			p.Configurations.Streams = append(p.Configurations.Streams, publicStreamConfiguration{
+				Name:        s.Stream.Name,
+				SyncMode:    syncMode,
+				CursorField: s.Config.CursorField,
+				PrimaryKey:  s.Config.PrimaryKey,
+			})
+		}
+	}
+
+	return p, nil
+}
+
+func (c *PublicApiClient) GetConnectionById(ctx context.Context, connectionId string) (*Connection, error) {
+	connection := publicConnection{}
+	err := c.do(ctx, "GET", fmt.Sprintf("connections/%s", connectionId), nil, &connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return connection.toConnection(), nil
+}
+
+func (c *PublicApiClient) ListConnections(ctx context.Context, workspaceId string) ([]*Connection, error) {
+	connections, err := list[publicConnection](ctx, c, "connections", workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cl []*Connection
+	for _, connection := range connections {
+		cl = append(cl, connection.toConnection())
+	}
+	return cl, nil
+}
+
+func (c *PublicApiClient) CreateConnection(ctx context.Context, newConnection NewConnection) (*Connection, error) {
+	p, err := toPublicConnection(newConnection.CommonConnectionFields)
+	if err != nil {
+		return nil, err
+	}
+	p.SourceId = newConnection.SourceId
+	p.DestinationId = newConnection.DestinationId
+
+	connection := publicConnection{}
+	err = c.create(ctx, "connections", p, &connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return connection.toConnection(), nil
+}
+
+func (c *PublicApiClient) UpdateConnection(ctx context.Context, updatedConnection UpdatedConnection) (*Connection, error) {
+	p, err := toPublicConnection(updatedConnection.CommonConnectionFields)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := publicConnection{}
+	err = c.do(ctx, "PATCH", fmt.Sprintf("connections/%s", updatedConnection.ConnectionId), p, &connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return connection.toConnection(), nil
+}
+
+func (c *PublicApiClient) DeleteConnection(ctx context.Context, connectionId string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("connections/%s", connectionId), nil, nil)
+}
@@ -0,0 +1,136 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type publicConnector struct {
+	SourceId      string          `json:"sourceId,omitempty"`
+	DestinationId string          `json:"destinationId,omitempty"`
+	DefinitionId  string          `json:"definitionId,omitempty"`
+	WorkspaceId   string          `json:"workspaceId,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Configuration json.RawMessage `json:"configuration,omitempty"`
+	// SourceType and DestinationType name the connector, e.g. "postgres"
+	SourceType      string `json:"sourceType,omitempty"`
+	DestinationType string `json:"destinationType,omitempty"`
+}
+
+func (p publicConnector) toConnector() *Connector {
+	connector := Connector{
+		WorkspaceIdBody: WorkspaceIdBody{WorkspaceId: p.WorkspaceId},
+		CommonConnectorFields: CommonConnectorFields{
+			Name:                    p.Name,
+			ConnectionConfiguration: p.Configuration,
+		},
+	}
+	if p.SourceId != "" {
+		connector.SourceId = p.SourceId
+		connector.SourceDefinitionId = p.DefinitionId
+		connector.SourceName = p.SourceType
+	} else {
+		connector.DestinationId = p.DestinationId
+		connector.DestinationDefinitionId = p.DefinitionId
+		connector.DestinationName = p.DestinationType
+	}
+	return &connector
+}
+
+func publicConnectorPath(t ConnectorType) (string, error) {
+	if t == SourceType {
+		return "sources", nil
+	} else if t == DestinationType {
+		return "destinations", nil
+	}
+	return "", fmt.Errorf("invalid ConnectorType: %d", t)
+}
+
+func (c *PublicApiClient) GetConnectorById(ctx context.Context, connectorId string, t ConnectorType) (*Connector, error) {
+	urlPath, err := publicConnectorPath(t)
+	if err != nil {
+		return nil, err
+	}
+
+	connector := publicConnector{}
+	err = c.do(ctx, "GET", fmt.Sprintf("%s/%s", urlPath, connectorId), nil, &connector)
+	if err != nil {
+		return nil, err
+	}
+
+	return connector.toConnector(), nil
+}
+
+func (c *PublicApiClient) ListConnectors(ctx context.Context, workspaceId string, t ConnectorType) ([]*Connector, error) {
+	urlPath, err := publicConnectorPath(t)
+	if err != nil {
+		return nil, err
+	}
+
+	connectors, err := list[publicConnector](ctx, c, urlPath, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cl []*Connector
+	for _, connector := range connectors {
+		cl = append(cl, connector.toConnector())
+	}
+	return cl, nil
+}
+
+func (c *PublicApiClient) CreateConnector(ctx context.Context, newConnector NewConnector, t ConnectorType) (*Connector, error) {
+	urlPath, err := publicConnectorPath(t)
+	if err != nil {
+		return nil, err
+	}
+
+	definitionId := newConnector.SourceDefinitionId
+	if t == DestinationType {
+		definitionId = newConnector.DestinationDefinitionId
+	}
+	connector := publicConnector{}
+	err = c.create(ctx, urlPath, publicConnector{
+		DefinitionId:  definitionId,
+		WorkspaceId:   newConnector.WorkspaceId,
+		Name:          newConnector.Name,
+		Configuration: newConnector.ConnectionConfiguration,
+	}, &connector)
+	if err != nil {
+		return nil, err
+	}
+
+	return connector.toConnector(), nil
+}
+
+func (c *PublicApiClient) UpdateConnector(ctx context.Context, updatedConnector UpdatedConnector, t ConnectorType) (*Connector, error) {
+	urlPath, err := publicConnectorPath(t)
+	if err != nil {
+		return nil, err
+	}
+
+	connectorId := updatedConnector.SourceId
+	if t == DestinationType {
+		connectorId = updatedConnector.DestinationId
+	}
+	connector := publicConnector{}
+	err = c.do(ctx, "PATCH", fmt.Sprintf("%s/%s", urlPath, connectorId), publicConnector{
+		Name:          updatedConnector.Name,
+		Configuration: updatedConnector.ConnectionConfiguration,
+	}, &connector)
+	if err != nil {
+		return nil, err
+	}
+
+	return connector.toConnector(), nil
+}
+
+func (c *PublicApiClient) DeleteConnector(ctx context.Context, connectorId string, t ConnectorType) error {
+	urlPath, err := publicConnectorPath(t)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, "DELETE", fmt.Sprintf("%s/%s", urlPath, connectorId), nil, nil)
+}
@@ -0,0 +1,151 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestPublicApiClient(t *testing.T, handler http.HandlerFunc) *PublicApiClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.Logger = nil
+	return NewPublicApiClient(&ApiClient{
+		HostURL:    server.URL,
+		Auth:       &BearerTokenAuth{Token: "api-key"},
+		HTTPClient: httpClient,
+	})
+}
+
+func TestPublicApiClientConnectors(t *testing.T) {
+	var requests []string
+	client := newTestPublicApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.RequestURI(), body))
+		if r.Header.Get("Authorization") != "Bearer api-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			if r.URL.Path == "/v1/sources/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"type": "https://reference.airbyte.com/reference/errors", "title": "resource-not-found", "status": 404}`)
+				return
+			}
+			fallthrough
+		default:
+			fmt.Fprint(w, `{"sourceId": "s1", "name": "test", "sourceType": "postgres", "definitionId": "d1", "workspaceId": "w1", "configuration": {"host": "db"}}`)
+		}
+	})
+	ctx := context.Background()
+
+	source, err := client.CreateConnector(ctx, NewConnector{
+		SourceDefinitionIdBody: SourceDefinitionIdBody{SourceDefinitionId: "d1"},
+		WorkspaceIdBody:        WorkspaceIdBody{WorkspaceId: "w1"},
+		CommonConnectorFields:  CommonConnectorFields{Name: "test", ConnectionConfiguration: json.RawMessage(`{"host":"db"}`)},
+	}, SourceType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if source.SourceId != "s1" || source.SourceDefinitionId != "d1" || source.SourceName != "postgres" || string(source.ConnectionConfiguration) != `{"host": "db"}` {
+		t.Fatalf("unexpected source: %+v", source)
+	}
+
+	_, err = client.UpdateConnector(ctx, UpdatedConnector{
+		SourceIdBody:          SourceIdBody{SourceId: "s1"},
+		CommonConnectorFields: CommonConnectorFields{Name: "renamed", ConnectionConfiguration: json.RawMessage(`{"host":"db"}`)},
+	}, SourceType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.GetConnectorById(ctx, "s1", SourceType); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var notFoundErr *NotFoundError
+	if _, err := client.GetConnectorById(ctx, "missing", SourceType); !errors.As(err, &notFoundErr) {
+		t.Fatalf("expected a NotFoundError, got %v", err)
+	}
+	if err := client.DeleteConnector(ctx, "s1", SourceType); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		`POST /v1/sources {"definitionId":"d1","workspaceId":"w1","name":"test","configuration":{"host":"db"}}`,
+		`PATCH /v1/sources/s1 {"name":"renamed","configuration":{"host":"db"}}`,
+		`GET /v1/sources/s1 `,
+		`GET /v1/sources/missing `,
+		`DELETE /v1/sources/s1 `,
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %q, got %q", expected, requests)
+	}
+}
+
+func TestPublicApiClientConnections(t *testing.T) {
+	var created publicConnection
+	client := newTestPublicApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && r.URL.Path == "/v1/connections" {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+		}
+		fmt.Fprint(w, `{
+			"connectionId": "c1", "name": "test", "sourceId": "s1", "destinationId": "d1", "workspaceId": "w1",
+			"status": "active", "dataResidency": "auto", "namespaceDefinition": "custom_format", "namespaceFormat": "${SOURCE_NAMESPACE}_raw",
+			"schedule": {"scheduleType": "basic", "basicTiming": "Every 24 hours"},
+			"configurations": {"streams": [{"name": "invoices", "syncMode": "incremental_deduped_history", "cursorField": ["updated_at"], "primaryKey": [["id"]]}]}
+		}`)
+	})
+	ctx := context.Background()
+
+	newConnection := NewConnection{
+		SourceIdBody:      SourceIdBody{SourceId: "s1"},
+		DestinationIdBody: DestinationIdBody{DestinationId: "d1"},
+		CommonConnectionFields: CommonConnectionFields{
+			Status:              "active",
+			NamespaceDefinition: "customformat",
+			ScheduleType:        "cron",
+			ScheduleData:        &ScheduleData{Cron: &CronScheduleSpec{CronExpression: "0 0 12 * * ?", CronTimeZone: "UTC"}},
+			SyncCatalog: &SyncCatalog{Streams: []Stream{{
+				Stream: SourceStreamSchema{Name: "invoices"},
+				Config: DestinationStreamConfig{SyncMode: "full_refresh", DestinationSyncMode: "append"},
+			}}},
+		},
+	}
+	connection, err := client.CreateConnection(ctx, newConnection)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if created.NamespaceDefinition != "custom_format" || created.Schedule.CronExpression != "0 0 12 * * ?" ||
+		created.Configurations.Streams[0].SyncMode != "full_refresh_append" {
+		t.Fatalf("unexpected request: %+v", created)
+	}
+
+	if connection.NamespaceDefinition != "customformat" || connection.Geography != "auto" {
+		t.Fatalf("unexpected connection: %+v", connection)
+	}
+	if basic := connection.ScheduleData.BasicSchedule; basic == nil || basic.Units != 24 || basic.TimeUnit != "hours" {
+		t.Fatalf("unexpected schedule: %+v", connection.ScheduleData)
+	}
+	stream := connection.SyncCatalog.Streams[0]
+	if stream.Config.SyncMode != "incremental" || stream.Config.DestinationSyncMode != "append_dedup" || !*stream.Config.Selected {
+		t.Fatalf("unexpected stream: %+v", stream)
+	}
+
+	newConnection.ScheduleType = "basic"
+	var unsupportedErr *UnsupportedError
+	if _, err := client.CreateConnection(ctx, newConnection); !errors.As(err, &unsupportedErr) {
+		t.Fatalf("expected an UnsupportedError for basic schedules, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+)
+
+type publicWorkspace struct {
+	WorkspaceId   string `json:"workspaceId,omitempty"`
+	Name          string `json:"name,omitempty"`
+	DataResidency string `json:"dataResidency,omitempty"`
+}
+
+func (w publicWorkspace) toWorkspace() *Workspace {
+	return &Workspace{
+		WorkspaceIdBody:       WorkspaceIdBody{WorkspaceId: w.WorkspaceId},
+		WorkspaceNameBody:     WorkspaceNameBody{Name: w.Name},
+		CommonWorkspaceFields: CommonWorkspaceFields{DefaultGeography: w.DataResidency},
+	}
+}
+
+func (c *PublicApiClient) GetWorkspaceById(ctx context.Context, workspaceId string) (*Workspace, error) {
+	workspace := publicWorkspace{}
+	err := c.do(ctx, "GET", fmt.Sprintf("workspaces/%s", workspaceId), nil, &workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace.toWorkspace(), nil
+}
+
+func (c *PublicApiClient) GetWorkspaceBySlug(ctx context.Context, slug string) (*Workspace, error) {
+	return nil, unsupported("looking up workspaces by slug")
+}
+
+func (c *PublicApiClient) GetWorkspaces(ctx context.Context) ([]*Workspace, error) {
+	workspaces, err := list[publicWorkspace](ctx, c, "workspaces", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var wl []*Workspace
+	for _, w := range workspaces {
+		wl = append(wl, w.toWorkspace())
+	}
+	return wl, nil
+}
+
+func (c *PublicApiClient) CreateWorkspace(ctx context.Context, newWorkspace NewWorkspace) (*Workspace, error) {
+	workspace := publicWorkspace{}
+	err := c.create(ctx, "workspaces", publicWorkspace{Name: newWorkspace.Name, DataResidency: newWorkspace.DefaultGeography}, &workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace.toWorkspace(), nil
+}
+
+// UpdateWorkspace returns the workspace as it is, as the only setting the public API can update is
+// its name, which UpdatedWorkspace doesn't hold.
+func (c *PublicApiClient) UpdateWorkspace(ctx context.Context, updatedWorkspace UpdatedWorkspace) (*Workspace, error) {
+	return c.GetWorkspaceById(ctx, updatedWorkspace.WorkspaceId)
+}
+
+func (c *PublicApiClient) DeleteWorkspace(ctx context.Context, workspaceId string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("workspaces/%s", workspaceId), nil, nil)
+}
@@ -0,0 +1,127 @@
+package apiclient
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimiter is a token bucket limiting the rate of requests sent to the Airbyte API, for
+// deployments behind a gateway enforcing a request quota. It adapts to the quota: every 429 response
+// halves the rate and pauses all requests for as long as its Retry-After header asks, and each
+// other response raises the rate back towards the configured one.
+type RateLimiter struct {
+	mu sync.Mutex
+	// rate is the configured number of requests per second, and current the one in effect
+	rate    float64
+	current float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	// pausedUntil holds back all requests after a 429 response with a Retry-After header
+	pausedUntil time.Time
+}
+
+// rateLimitMinFraction is how far 429 responses can lower the rate, as a fraction of the configured one
+const rateLimitMinFraction = 1.0 / 16
+
+// rateLimitRecoveryFraction is how much each successful response raises the rate, as a fraction of the configured one
+const rateLimitRecoveryFraction = 1.0 / 20
+
+// NewRateLimiter returns a RateLimiter allowing requestsPerSecond on average, and up to burst requests at once
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:    requestsPerSecond,
+		current: requestsPerSecond,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		last:    time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent, or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// reserve takes a token and returns 0 if one is available at now, or else how long to wait for one
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.pausedUntil) {
+		l.last = l.pausedUntil
+		return l.pausedUntil.Sub(now)
+	}
+	if now.After(l.last) {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.current)
+		l.last = now
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.current * float64(time.Second))
+}
+
+// observe adapts the rate to the response status
+func (l *RateLimiter) observe(ctx context.Context, res *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		l.current = math.Min(l.rate, l.current+l.rate*rateLimitRecoveryFraction)
+		return
+	}
+
+	l.current = math.Max(l.rate*rateLimitMinFraction, l.current/2)
+	l.tokens = 0
+	if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+		if until := time.Now().Add(wait); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+	tflog.SubsystemWarn(ctx, logSubsystem, "Airbyte API rate limit exceeded, slowing down", map[string]interface{}{
+		"requests_per_second": l.current,
+		"paused_until":        l.pausedUntil.Format(time.RFC3339),
+	})
+}
+
+// Transport returns a RoundTripper sending requests through base at the limiter's rate. As it wraps
+// the transport of the API client, every attempt of a request, retries included, is rate limited.
+func (l *RateLimiter) Transport(base http.RoundTripper) http.RoundTripper {
+	return &rateLimitedTransport{base: base, limiter: l}
+}
+
+type rateLimitedTransport struct {
+	base    http.RoundTripper
+	limiter *RateLimiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	res, err := t.base.RoundTrip(req)
+	if err == nil {
+		t.limiter.observe(req.Context(), res)
+	}
+	return res, err
+}
@@ -0,0 +1,122 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := NewRateLimiter(10, 2)
+	now := l.last
+
+	// The burst goes through at once, then requests are spaced by 100ms
+	for i := 0; i < 2; i++ {
+		if wait := l.reserve(now); wait != 0 {
+			t.Fatalf("request %d: expected no wait, got %s", i, wait)
+		}
+	}
+	if wait := l.reserve(now); wait != 100*time.Millisecond {
+		t.Fatalf("expected to wait 100ms, got %s", wait)
+	}
+	if wait := l.reserve(now.Add(100 * time.Millisecond)); wait != 0 {
+		t.Fatalf("expected no wait, got %s", wait)
+	}
+
+	// A 429 halves the rate, and pauses requests for as long as Retry-After asks
+	l.observe(context.Background(), &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"1"}}})
+	if l.current != 5 {
+		t.Fatalf("expected 5 requests per second, got %f", l.current)
+	}
+	if wait := l.reserve(time.Now()); wait < 900*time.Millisecond || wait > time.Second {
+		t.Fatalf("expected to wait about 1s, got %s", wait)
+	}
+
+	// Successful responses restore the configured rate
+	for i := 0; i < 20; i++ {
+		l.observe(context.Background(), &http.Response{StatusCode: http.StatusOK})
+	}
+	if l.current != 10 {
+		t.Fatalf("expected 10 requests per second, got %f", l.current)
+	}
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	l := NewRateLimiter(1000, 1)
+	client := &http.Client{Transport: l.Transport(http.DefaultTransport)}
+	for i := 0; i < 3; i++ {
+		res, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		res.Body.Close()
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+	if l.current <= 500 || l.current >= 1000 {
+		t.Fatalf("expected the rate to recover partially from the 429, got %f", l.current)
+	}
+
+	// Waiting gives up with the context
+	l = NewRateLimiter(0.001, 1)
+	l.reserve(time.Now())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected the context's error, got: %v", err)
+	}
+}
+
+func TestRateLimitedCreate(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"workspaceId": "w"}`)
+	}))
+	defer server.Close()
+
+	l := NewRateLimiter(1000, 1)
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{Transport: l.Transport(http.DefaultTransport)}
+	httpClient.RetryMax = 4
+	httpClient.RetryWaitMin = time.Hour
+	httpClient.RetryWaitMax = time.Hour
+	httpClient.CheckRetry = RetryPolicy{}.CheckRetry
+	httpClient.Backoff = RetryBackoff
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+
+	// A create over the quota is slowed down and retried, rather than failed
+	workspace, err := client.CreateWorkspace(context.Background(), NewWorkspace{WorkspaceNameBody: WorkspaceNameBody{Name: "test"}})
+	if err != nil || workspace.WorkspaceId != "w" {
+		t.Fatalf("expected the create to succeed once paced, got %+v, %v", workspace, err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+	if l.current >= 1000 {
+		t.Fatalf("expected the 429 to lower the rate, got %f", l.current)
+	}
+}
@@ -0,0 +1,272 @@
+package apiclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+// RecorderMode selects whether a Recorder records or replays interactions
+type RecorderMode int
+
+const (
+	// ModeReplay answers requests from the cassette without any network access
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests to Airbyte and stores the interactions in the cassette
+	ModeRecord
+)
+
+// Recorder is an http.RoundTripper that records the interactions of an ApiClient with Airbyte into a
+// cassette file, or replays them from it, VCR style. Requests are matched on their method, path and
+// body, so a cassette recorded against one Airbyte can be replayed with any HostURL. Credentials and
+// secrets in request bodies are masked before they are stored, and so are the credentials in response
+// bodies, see cassetteSecretKeys.
+type Recorder struct {
+	mode      RecorderMode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette cassette
+	replayed []bool
+}
+
+// cassetteSecretKeys are masked wherever they hold a string in the response bodies stored in cassettes,
+// such as the access_token of applications/token and Slack webhook URLs. connectionConfiguration is
+// kept as it is, as Airbyte masks its secrets itself and cassettes exist to capture that.
+var cassetteSecretKeys = map[string]bool{
+	"access_token":  true,
+	"token":         true,
+	"client_secret": true,
+	"password":      true,
+	"webhook":       true,
+	"executionBody": true,
+}
+
+type cassette struct {
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       json.RawMessage   `json:"body,omitempty"`
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In ModeRecord, requests are sent through
+// transport, http.DefaultTransport if nil, and the cassette is written by Save.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+	}
+
+	if mode == ModeReplay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(b, &r.cassette)
+		if err != nil {
+			return nil, fmt.Errorf("decoding cassette %s failed: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(b))
+		reqBody = b
+	}
+	recorded := recordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Body:   cassetteBody([]byte(redactBody(reqBody))),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction{
+		Request: recorded,
+		Response: recordedResponse{
+			StatusCode: res.StatusCode,
+			Headers:    redactHeaders(res.Header, secretHeadersOf(req.Context())),
+			Body:       cassetteBody(redactResponseBody(resBody)),
+		},
+	})
+
+	return res, nil
+}
+
+// replay answers req with the first interaction matching it that wasn't replayed yet
+func (r *Recorder) replay(req *http.Request, recorded recordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !interaction.Request.matches(recorded) {
+			continue
+		}
+		r.replayed[i] = true
+
+		res := &http.Response{
+			Status:     fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode: interaction.Response.StatusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader(bodyFromCassette(interaction.Response.Body))),
+			Request:    req,
+		}
+		for k, v := range interaction.Response.Headers {
+			res.Header.Set(k, v)
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("no interaction for %s %s left in cassette %s", req.Method, req.URL.Path, r.path)
+}
+
+// Save writes the recorded interactions to the cassette. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(r.path), 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+func (req recordedRequest) matches(other recordedRequest) bool {
+	if req.Method != other.Method || req.Path != other.Path {
+		return false
+	}
+	if len(req.Body) == 0 || len(other.Body) == 0 {
+		return len(req.Body) == len(other.Body)
+	}
+
+	// Compare decoded bodies, so hand-edited cassettes don't have to match key order or whitespace
+	var a, b interface{}
+	if json.Unmarshal(req.Body, &a) != nil || json.Unmarshal(other.Body, &b) != nil {
+		return bytes.Equal(req.Body, other.Body)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// redactResponseBody returns body with the string values of cassetteSecretKeys masked. Bodies
+// without any are returned as they are, so cassettes keep Airbyte's formatting.
+func redactResponseBody(body []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+	if !redactStrings(v) {
+		return body
+	}
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactStrings masks the string values of cassetteSecretKeys in v, and returns whether it masked any
+func redactStrings(v interface{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if s, ok := value.(string); ok && cassetteSecretKeys[k] && s != redactedValue {
+				v[k] = redactedValue
+				redacted = true
+			} else if redactStrings(value) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if redactStrings(value) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// cassetteBody stores JSON bodies as they are, so cassettes stay readable, and others as JSON strings
+func cassetteBody(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) && body[0] != '"' {
+		return body
+	}
+	b, _ := json.Marshal(string(body))
+	return b
+}
+
+func bodyFromCassette(body json.RawMessage) []byte {
+	if len(body) > 0 && body[0] == '"' {
+		var s string
+		if json.Unmarshal(body, &s) == nil {
+			return []byte(s)
+		}
+	}
+	return body
+}
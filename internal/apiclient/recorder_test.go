@@ -0,0 +1,178 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newCassetteClient returns a client replaying testdata/<name>.json. With AIRBYTE_RECORD_URL set, it
+// records its interactions with that Airbyte into the cassette instead, authenticating with
+// AIRBYTE_USERNAME and AIRBYTE_PASSWORD.
+func newCassetteClient(t *testing.T, name string) *ApiClient {
+	path := filepath.Join("testdata", name+".json")
+	hostURL, record := os.LookupEnv("AIRBYTE_RECORD_URL")
+
+	mode := ModeReplay
+	var auth Authenticator
+	if record {
+		mode = ModeRecord
+		auth = &BasicAuth{Username: os.Getenv("AIRBYTE_USERNAME"), Password: os.Getenv("AIRBYTE_PASSWORD")}
+	} else {
+		hostURL = "http://airbyte.invalid"
+	}
+
+	recorder, err := NewRecorder(path, mode, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := recorder.Save(); err != nil {
+			t.Errorf("unable to save cassette: %s", err)
+		}
+	})
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{Transport: recorder}
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	return &ApiClient{HostURL: hostURL, Auth: auth, HTTPClient: httpClient}
+}
+
+func TestRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"workspaceId": "w1", "name": "recorded"}`)
+	}))
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{Transport: recorder}
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, Auth: &BasicAuth{Username: "airbyte", Password: "hunter2"}, HTTPClient: httpClient}
+	if _, err := client.GetWorkspaceById(context.Background(), "w1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	server.Close()
+
+	recorder, err = NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	httpClient.HTTPClient = &http.Client{Transport: recorder}
+	client.HostURL = "http://airbyte.invalid"
+	workspace, err := client.GetWorkspaceById(context.Background(), "w1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workspace.Name != "recorded" {
+		t.Fatalf("expected the recorded workspace, got %s", workspace.Name)
+	}
+
+	// Each interaction is replayed once, and requests that weren't recorded fail
+	if _, err := client.GetWorkspaceById(context.Background(), "w1"); err == nil {
+		t.Fatalf("expected an error for an interaction that was already replayed")
+	}
+}
+
+func TestRecorderMasksResponseCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/applications/token" {
+			fmt.Fprint(w, `{"access_token": "issued-token", "token_type": "Bearer", "expires_in": 180}`)
+			return
+		}
+		fmt.Fprint(w, `{"sourceId": "s1", "name": "pg", "connectionConfiguration": {"host": "db.internal", "password": "**********"}}`)
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{Transport: recorder}
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := ApiClient{
+		HostURL:    server.URL,
+		Auth:       &ClientCredentialsAuth{ClientId: "id", ClientSecret: "client-secret"},
+		HTTPClient: httpClient,
+	}
+	if _, err := client.GetConnectorById(context.Background(), "s1", SourceType); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if bytes.Contains(b, []byte("issued-token")) || bytes.Contains(b, []byte("client-secret")) {
+		t.Fatalf("expected credentials to be masked, got: %s", b)
+	}
+	var recorded cassette
+	if err := json.Unmarshal(b, &recorded); err != nil || len(recorded.Interactions) != 2 {
+		t.Fatalf("expected 2 interactions, got %s, %v", b, err)
+	}
+	token := ApplicationToken{}
+	if err := json.Unmarshal(recorded.Interactions[0].Response.Body, &token); err != nil || token.AccessToken != redactedValue || token.ExpiresIn != 180 {
+		t.Fatalf("expected the access token to be masked, got %s", recorded.Interactions[0].Response.Body)
+	}
+	source := Connector{}
+	configuration := bytes.Buffer{}
+	if err := json.Unmarshal(recorded.Interactions[1].Response.Body, &source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := json.Compact(&configuration, source.ConnectionConfiguration); err != nil || configuration.String() != `{"host":"db.internal","password":"**********"}` {
+		t.Fatalf("expected the connection configuration to be kept, got %s", recorded.Interactions[1].Response.Body)
+	}
+}
+
+func TestCassetteMaskedSourceAndNestedCatalog(t *testing.T) {
+	ctx := context.Background()
+	client := newCassetteClient(t, "masked_source_nested_catalog")
+
+	source, err := client.GetConnectorById(ctx, "6c4c3b1e-6d7a-4b4e-9f0a-2a4c0f6c1d2e", SourceType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	configuration := bytes.Buffer{}
+	if err := json.Compact(&configuration, source.ConnectionConfiguration); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := configuration.String(); got != `{"host":"db.internal","password":"**********","port":5432,"tunnel_method":{"ssh_key":"**********","tunnel_method":"SSH_KEY_AUTH"}}` {
+		t.Fatalf("unexpected configuration: %s", got)
+	}
+
+	catalog, err := client.GetSourceSchemaCatalogById(ctx, source.SourceId)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	streams := catalog.Catalog.Streams
+	if len(streams) != 2 {
+		t.Fatalf("expected 2 streams, got %d", len(streams))
+	}
+	if pk := streams[1].Stream.SourceDefinedPrimaryKey; len(pk) != 2 || len(pk[1]) != 2 || pk[1][1] != "id" {
+		t.Fatalf("expected a composite, nested primary key, got %v", pk)
+	}
+	if streams[1].Stream.Namespace != "billing" || streams[1].Config.AliasName != "billing_invoices" {
+		t.Fatalf("unexpected stream: %+v", streams[1])
+	}
+}
@@ -0,0 +1,116 @@
+package apiclient
+
+import (
+	"context"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type nonIdempotentKey struct{}
+
+// withNonIdempotent marks the request made with ctx as one that must not be sent twice,
+// e.g. because a retry after a timeout would create a duplicate object.
+func withNonIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, nonIdempotentKey{}, true)
+}
+
+func isNonIdempotent(ctx context.Context) bool {
+	v, _ := ctx.Value(nonIdempotentKey{}).(bool)
+	return v
+}
+
+// isCreateRequest reports whether req creates a new object in Airbyte, such as sources/create or
+// source_definitions/create_custom.
+func isCreateRequest(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/create") || strings.HasSuffix(req.URL.Path, "/create_custom")
+}
+
+// RetryPolicy decides which failed requests to the Airbyte API are retried.
+type RetryPolicy struct {
+	// StatusCodes are the response statuses to retry. Defaults to 429 and any 5xx but 501.
+	StatusCodes []int
+	// RetryCreates allows retrying requests that create objects after transport errors and any of the
+	// StatusCodes, which can create duplicates if the failed attempt reached Airbyte. Without it they are
+	// only retried when Airbyte rejected them, see isRejected.
+	RetryCreates bool
+}
+
+// CheckRetry is a retryablehttp.CheckRetry for the policy.
+func (p RetryPolicy) CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if !p.RetryCreates && isNonIdempotent(ctx) {
+		return err == nil && isRejected(resp), nil
+	}
+	if err != nil || len(p.StatusCodes) == 0 {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	for _, code := range p.StatusCodes {
+		if resp.StatusCode == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isRejected reports whether resp rejected the request without acting on it: a 429, or a 503 asking to
+// retry later with a Retry-After header.
+func isRejected(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	_, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+	return resp.StatusCode == http.StatusServiceUnavailable && ok
+}
+
+// RetryBackoff is a retryablehttp.Backoff that waits as long as 429 and 503 responses ask for in
+// their Retry-After header, up to max, and backs off exponentially otherwise.
+func RetryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > max {
+				return max
+			}
+			return wait
+		}
+	}
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, nil)
+}
+
+// parseRetryAfter parses a Retry-After header holding either a number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// RetryLogHook is a retryablehttp.RequestLogHook that logs each retry through tflog and counts
+// them for the log entry of the request.
+func RetryLogHook(_ retryablehttp.Logger, req *http.Request, attemptNum int) {
+	if attemptNum == 0 {
+		return
+	}
+	recordRetry(req.Context(), attemptNum)
+	tflog.SubsystemWarn(req.Context(), logSubsystem, "Retrying Airbyte API request", map[string]interface{}{
+		"method":   req.Method,
+		"endpoint": req.URL.Path,
+		"attempt":  attemptNum + 1,
+	})
+}
@@ -0,0 +1,138 @@
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	attempts := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts[r.URL.Path]++
+		if attempts[r.URL.Path] == 1 && r.URL.Path == "/api/v1/workspaces/create" {
+			// A gateway error without Retry-After leaves open whether the workspace was created
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if attempts[r.URL.Path] < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"available": true, "workspaceId": "w"}`)
+	}))
+	defer server.Close()
+
+	newClient := func(policy RetryPolicy) ApiClient {
+		httpClient := retryablehttp.NewClient()
+		httpClient.RetryMax = 4
+		httpClient.RetryWaitMin = time.Hour
+		httpClient.RetryWaitMax = time.Hour
+		httpClient.CheckRetry = policy.CheckRetry
+		httpClient.Backoff = RetryBackoff
+		httpClient.RequestLogHook = RetryLogHook
+		httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+		httpClient.Logger = nil
+		return ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+	}
+
+	// Retry-After: 0 overrides the hour long backoff
+	client := newClient(RetryPolicy{})
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attempts["/api/v1/health"] != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts["/api/v1/health"])
+	}
+
+	_, err := client.CreateWorkspace(context.Background(), NewWorkspace{WorkspaceNameBody: WorkspaceNameBody{Name: "test"}})
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected the failed create to be returned without retrying, got %v", err)
+	}
+	if attempts["/api/v1/workspaces/create"] != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts["/api/v1/workspaces/create"])
+	}
+
+	client = newClient(RetryPolicy{RetryCreates: true})
+	if _, err := client.CreateWorkspace(context.Background(), NewWorkspace{WorkspaceNameBody: WorkspaceNameBody{Name: "test"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attempts["/api/v1/workspaces/create"] != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts["/api/v1/workspaces/create"])
+	}
+
+	client = newClient(RetryPolicy{StatusCodes: []int{http.StatusTooManyRequests}})
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	delete(attempts, "/api/v1/health")
+	if err := client.Check(context.Background()); err == nil {
+		t.Fatalf("expected 503 not to be retried when only 429 is configured")
+	}
+}
+
+func TestRetryPolicyRejectedCreates(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, `{"workspaceId": "w"}`)
+		}
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 4
+	httpClient.RetryWaitMin = time.Millisecond
+	httpClient.RetryWaitMax = time.Millisecond
+	httpClient.CheckRetry = RetryPolicy{}.CheckRetry
+	httpClient.Backoff = RetryBackoff
+	httpClient.Logger = nil
+	client := ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+
+	// Airbyte didn't act on the rejected attempts, so retrying them can't create duplicates
+	workspace, err := client.CreateWorkspace(context.Background(), NewWorkspace{WorkspaceNameBody: WorkspaceNameBody{Name: "test"}})
+	if err != nil || workspace.WorkspaceId != "w" {
+		t.Fatalf("expected the create to be retried, got %+v, %v", workspace, err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"7"}}}
+	if wait := RetryBackoff(time.Second, time.Minute, 1, resp); wait != 7*time.Second {
+		t.Fatalf("expected 7s, got %s", wait)
+	}
+	resp.Header.Set("Retry-After", "86400")
+	if wait := RetryBackoff(time.Second, time.Minute, 1, resp); wait != time.Minute {
+		t.Fatalf("expected the wait to be capped at 1m, got %s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("7"); !ok || wait != 7*time.Second {
+		t.Fatalf("expected 7s, got %s", wait)
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 50*time.Second || wait > time.Minute {
+		t.Fatalf("expected about a minute, got %s", wait)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Fatalf("expected an invalid header to be ignored")
+	}
+}
@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -45,17 +46,23 @@ type DestinationStreamConfig struct {
 	Selected    *bool      `json:"selected,omitempty"`
 }
 
-func (c *ApiClient) GetSourceSchemaCatalogById(sourceId string) (*SourceSchemaCatalog, error) {
+func (c *ApiClient) GetSourceSchemaCatalogById(ctx context.Context, sourceId string) (*SourceSchemaCatalog, error) {
 	rb, err := json.Marshal(SourceIdBody{SourceId: sourceId})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/sources/discover_schema", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/sources/discover_schema", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
+	release, err := c.startJob(ctx, "discover_schema")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
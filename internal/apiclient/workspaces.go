@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,6 +23,7 @@ type CommonWorkspaceFields struct {
 	SecurityUpdates         *bool          `json:"securityUpdates,omitempty"`
 	Notifications           []Notification `json:"notifications,omitempty"`
 	DisplaySetupWizard      *bool          `json:"displaySetupWizard,omitempty"`
+	DefaultGeography        string         `json:"defaultGeography,omitempty"`
 }
 
 type Workspace struct {
@@ -35,7 +37,6 @@ type Workspace struct {
 	Notifications        []Notification `json:"notifications"`
 	FirstCompletedSync   *bool          `json:"firstCompletedSync,omitempty"`
 	FeedbackDone         *bool          `json:"feedbackDone,omitempty"`
-	DefaultGeography     string         `json:"defaultGeography"`
 }
 
 type NewWorkspace struct {
@@ -63,7 +64,7 @@ type WorkspaceList struct {
 	Workspaces []*Workspace `json:"workspaces"`
 }
 
-func (c *ApiClient) GetWorkspaceById(workspaceId string) (*Workspace, error) {
+func (c *ApiClient) GetWorkspaceById(ctx context.Context, workspaceId string) (*Workspace, error) {
 	rb, err := json.Marshal(WorkspaceIdBody{
 		WorkspaceId: workspaceId,
 	})
@@ -71,7 +72,7 @@ func (c *ApiClient) GetWorkspaceById(workspaceId string) (*Workspace, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/workspaces/get", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/workspaces/get", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +91,7 @@ func (c *ApiClient) GetWorkspaceById(workspaceId string) (*Workspace, error) {
 	return &workspace, nil
 }
 
-func (c *ApiClient) GetWorkspaceBySlug(slug string) (*Workspace, error) {
+func (c *ApiClient) GetWorkspaceBySlug(ctx context.Context, slug string) (*Workspace, error) {
 	rb, err := json.Marshal(struct {
 		Slug string `json:"slug"`
 	}{
@@ -100,7 +101,7 @@ func (c *ApiClient) GetWorkspaceBySlug(slug string) (*Workspace, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/workspaces/get_by_slug", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/workspaces/get_by_slug", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +120,8 @@ func (c *ApiClient) GetWorkspaceBySlug(slug string) (*Workspace, error) {
 	return &workspace, nil
 }
 
-func (c *ApiClient) GetWorkspaces() ([]*Workspace, error) {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/workspaces/list", c.HostURL, BaseUrl), nil)
+func (c *ApiClient) GetWorkspaces(ctx context.Context) ([]*Workspace, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/workspaces/list", c.HostURL, BaseUrl), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -139,19 +140,24 @@ func (c *ApiClient) GetWorkspaces() ([]*Workspace, error) {
 	return wl.Workspaces, nil
 }
 
-func (c *ApiClient) CreateWorkspace(newWorkspace NewWorkspace) (*Workspace, error) {
+func (c *ApiClient) CreateWorkspace(ctx context.Context, newWorkspace NewWorkspace) (*Workspace, error) {
 	rb, err := json.Marshal(newWorkspace)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/workspaces/create", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/workspaces/create", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
 	body, err := c.doRequest(req)
 	if err != nil {
+		if c.RecoverCreates && IsTransportError(err) {
+			if workspace := c.findCreatedWorkspace(ctx, newWorkspace); workspace != nil {
+				return workspace, nil
+			}
+		}
 		return nil, err
 	}
 
@@ -164,13 +170,13 @@ func (c *ApiClient) CreateWorkspace(newWorkspace NewWorkspace) (*Workspace, erro
 	return &workspace, nil
 }
 
-func (c *ApiClient) UpdateWorkspace(updatedWorkspace UpdatedWorkspace) (*Workspace, error) {
+func (c *ApiClient) UpdateWorkspace(ctx context.Context, updatedWorkspace UpdatedWorkspace) (*Workspace, error) {
 	rb, err := json.Marshal(updatedWorkspace)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/workspaces/update", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/workspaces/update", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -189,13 +195,13 @@ func (c *ApiClient) UpdateWorkspace(updatedWorkspace UpdatedWorkspace) (*Workspa
 	return &workspace, nil
 }
 
-func (c *ApiClient) DeleteWorkspace(workspaceId string) error {
+func (c *ApiClient) DeleteWorkspace(ctx context.Context, workspaceId string) error {
 	rb, err := json.Marshal(WorkspaceIdBody{WorkspaceId: workspaceId})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/workspaces/delete", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s/workspaces/delete", c.HostURL, BaseUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
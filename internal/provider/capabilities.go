@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+// addUnsupportedAttributeError reports that attribute, found at p, can't be applied as the server
+// lacks capability. This is raised before sending the request, which Airbyte would reject with a
+// validation error that doesn't tell why.
+func addUnsupportedAttributeError(diags *diag.Diagnostics, p path.Path, attribute string, capabilities apiclient.Capabilities, capability apiclient.Capability) {
+	diags.AddAttributeError(
+		p,
+		"Unsupported by this Airbyte Version",
+		fmt.Sprintf("Attribute %s requires Airbyte >= %s, but the server runs Airbyte %s.", attribute, capability.MinVersion, capabilities.Version),
+	)
+}
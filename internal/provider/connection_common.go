@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
@@ -122,3 +123,72 @@ func FlattenConnection(connection *apiclient.Connection) (ConnectionModel, diag.
 
 	return data, diags
 }
+
+// keepUnreportedConnectionFields copies the settings the public API doesn't return over from prior, the
+// plan or prior state, and warns about the ones that are set in plan.
+func keepUnreportedConnectionFields(state *ConnectionModel, prior ConnectionModel, diags *diag.Diagnostics) {
+	if diags != nil {
+		warnIgnoredAttributes(diags, map[string]attr.Value{
+			"source_catalog_id": prior.SourceCatalogId,
+		})
+		if len(prior.OperationIds.Elements()) > 0 {
+			warnIgnoredAttribute(diags, path.Root("operation_ids"))
+		}
+		if prior.ResourceRequirements != nil {
+			warnIgnoredAttribute(diags, path.Root("resource_requirements"))
+		}
+		if prior.CronSchedule != nil && prior.CronSchedule.CronTimeZone.ValueString() != "UTC" {
+			warnIgnoredAttribute(diags, path.Root("cron_schedule").AtName("cron_time_zone"))
+		}
+	}
+
+	if !prior.OperationIds.IsUnknown() {
+		state.OperationIds = prior.OperationIds
+	}
+	state.ResourceRequirements = prior.ResourceRequirements
+	state.SourceCatalogId = prior.SourceCatalogId
+	if state.CronSchedule != nil && prior.CronSchedule != nil {
+		state.CronSchedule.CronTimeZone = prior.CronSchedule.CronTimeZone
+	}
+	state.SyncCatalog = keepUnreportedStreamFields(state.SyncCatalog, prior.SyncCatalog)
+}
+
+// keepUnreportedStreamFields returns streams with the source schema and alias of the stream of the same
+// name in prior, in the order of prior. Unselected streams, which the public API leaves out, are kept as
+// they are in prior.
+func keepUnreportedStreamFields(streams *[]SyncCatalogModel, prior *[]SyncCatalogModel) *[]SyncCatalogModel {
+	if streams == nil || prior == nil {
+		return streams
+	}
+
+	reported := map[string]SyncCatalogModel{}
+	for _, stream := range *streams {
+		reported[stream.SourceSchema.Name.ValueString()] = stream
+	}
+
+	var merged []SyncCatalogModel
+	for _, priorStream := range *prior {
+		name := priorStream.SourceSchema.Name.ValueString()
+		priorStream.SourceSchema.SourceDefinedCursor = knownBool(priorStream.SourceSchema.SourceDefinedCursor)
+
+		stream, ok := reported[name]
+		if !ok {
+			if selected := priorStream.DestinationConfig.Selected; !selected.IsUnknown() && !selected.ValueBool() {
+				merged = append(merged, priorStream)
+			}
+			continue
+		}
+		delete(reported, name)
+
+		stream.SourceSchema = priorStream.SourceSchema
+		stream.DestinationConfig.AliasName = priorStream.DestinationConfig.AliasName
+		merged = append(merged, stream)
+	}
+	for _, stream := range *streams {
+		if _, ok := reported[stream.SourceSchema.Name.ValueString()]; ok {
+			merged = append(merged, stream)
+		}
+	}
+
+	return &merged
+}
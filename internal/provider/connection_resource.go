@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
@@ -26,7 +27,12 @@ func NewConnectionResource() resource.Resource {
 
 // ConnectionResource defines the resource implementation.
 type ConnectionResource struct {
-	client *apiclient.ApiClient
+	client       apiclient.ConnectionsAPI
+	capabilities apiclient.Capabilities
+	// omitsAttributes is set when the client doesn't return every attribute of connections, see
+	// apiclient.Client.ReportsAllAttributes. The planned or prior values of those are kept, so they
+	// don't show up as drift.
+	omitsAttributes bool
 }
 
 func (r *ConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -320,7 +326,9 @@ func (r *ConnectionResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.
 	}, nil
 }
 
-func getCommonConnectionFields(data ConnectionModel) apiclient.CommonConnectionFields {
+// getCommonConnectionFields returns the fields of data to send to Airbyte, leaving out those the
+// server's version doesn't have, which it would reject the request for
+func getCommonConnectionFields(data ConnectionModel, capabilities apiclient.Capabilities) apiclient.CommonConnectionFields {
 	fields := apiclient.CommonConnectionFields{
 		Status: data.Status.ValueString(),
 	}
@@ -443,7 +451,7 @@ func getCommonConnectionFields(data ConnectionModel) apiclient.CommonConnectionF
 	if v := data.SourceCatalogId; !v.IsUnknown() {
 		fields.SourceCatalogId = v.ValueString()
 	}
-	if v := data.BreakingChange; !v.IsUnknown() {
+	if v := data.BreakingChange; !v.IsUnknown() && capabilities.Supports(apiclient.CapabilityBreakingChange) {
 		b := v.ValueBool()
 		fields.BreakingChange = &b
 	}
@@ -451,24 +459,40 @@ func getCommonConnectionFields(data ConnectionModel) apiclient.CommonConnectionF
 	return fields
 }
 
+// selectedStreamIndexes returns the sync_catalog index of each stream sent to Airbyte, as
+// getCommonConnectionFields leaves out the streams that aren't selected.
+func selectedStreamIndexes(data ConnectionModel) []int {
+	var indexes []int
+	if data.SyncCatalog != nil {
+		for i, cfg := range *data.SyncCatalog {
+			if selected := cfg.DestinationConfig.Selected; selected.IsUnknown() || selected.ValueBool() {
+				indexes = append(indexes, i)
+			}
+		}
+	}
+	return indexes
+}
+
 func (r *ConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.capabilities = data.Client.Capabilities()
+	r.omitsAttributes = !data.Client.ReportsAllAttributes()
 }
 
 func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -481,7 +505,7 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	newConnection := apiclient.NewConnection{
-		CommonConnectionFields: getCommonConnectionFields(plan),
+		CommonConnectionFields: getCommonConnectionFields(plan, r.capabilities),
 		SourceIdBody: apiclient.SourceIdBody{
 			SourceId: plan.SourceId.ValueString(),
 		},
@@ -490,17 +514,23 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		},
 	}
 
-	connection, err := r.client.CreateConnection(newConnection)
+	connection, err := r.client.CreateConnection(ctx, newConnection)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectionPropertyPaths.withListIndexes("syncCatalog.streams", selectedStreamIndexes(plan)),
 			"Error creating connection",
-			"Could not create connection, unexpected error: "+err.Error(),
+			"Could not create connection, unexpected error: ",
+			err,
 		)
 		return
 	}
 
 	state, diags := FlattenConnection(connection)
 	resp.Diagnostics.Append(diags...)
+	if r.omitsAttributes {
+		keepUnreportedConnectionFields(&state, plan, &resp.Diagnostics)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -516,14 +546,23 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	connectionId := state.Id.ValueString()
 
-	connection, err := r.client.GetConnectionById(connectionId)
+	connection, err := r.client.GetConnectionById(ctx, connectionId)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connection, got error: %s", err))
 		return
 	}
 
+	prior := state
 	state, diags := FlattenConnection(connection)
 	resp.Diagnostics.Append(diags...)
+	if r.omitsAttributes {
+		keepUnreportedConnectionFields(&state, prior, nil)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -541,20 +580,26 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		ConnectionIdBody: apiclient.ConnectionIdBody{
 			ConnectionId: plan.Id.ValueString(),
 		},
-		CommonConnectionFields: getCommonConnectionFields(plan),
+		CommonConnectionFields: getCommonConnectionFields(plan, r.capabilities),
 	}
 
-	connection, err := r.client.UpdateConnection(updatedConnection)
+	connection, err := r.client.UpdateConnection(ctx, updatedConnection)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectionPropertyPaths.withListIndexes("syncCatalog.streams", selectedStreamIndexes(plan)),
 			"Error updating connection",
-			"Could not update connection, unexpected error: "+err.Error(),
+			"Could not update connection, unexpected error: ",
+			err,
 		)
 		return
 	}
 
 	state, diags := FlattenConnection(connection)
 	resp.Diagnostics.Append(diags...)
+	if r.omitsAttributes {
+		keepUnreportedConnectionFields(&state, plan, &resp.Diagnostics)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -569,7 +614,7 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	connectionId := state.Id.ValueString()
-	err := r.client.DeleteConnection(connectionId)
+	err := r.client.DeleteConnection(ctx, connectionId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating connection",
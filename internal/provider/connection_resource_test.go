@@ -4,9 +4,62 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
 
+func TestKeepUnreportedStreamFields(t *testing.T) {
+	stream := func(name string, jsonSchema string, selected bool) SyncCatalogModel {
+		return SyncCatalogModel{
+			SourceSchema: sourceStreamSchemaModel{
+				Name:                types.StringValue(name),
+				JsonSchema:          types.StringValue(jsonSchema),
+				SourceDefinedCursor: types.BoolUnknown(),
+			},
+			DestinationConfig: destinationStreamConfigModel{
+				SyncMode: types.StringValue("full_refresh"),
+				Selected: types.BoolValue(selected),
+			},
+		}
+	}
+	prior := []SyncCatalogModel{stream("a", `{"type":"object"}`, true), stream("b", `{}`, false), stream("c", `{}`, true)}
+	// The public API returns the selected streams, in its own order, without their schema
+	reported := []SyncCatalogModel{stream("new", "null", true), stream("c", "null", true), stream("a", "null", true)}
+	for i := range reported {
+		reported[i].SourceSchema.SourceDefinedCursor = types.BoolNull()
+	}
+
+	merged := *keepUnreportedStreamFields(&reported, &prior)
+
+	var names []string
+	for _, s := range merged {
+		names = append(names, s.SourceSchema.Name.ValueString())
+		if s.SourceSchema.SourceDefinedCursor.IsUnknown() {
+			t.Fatalf("expected unknown values to be nulled, got %+v", s.SourceSchema)
+		}
+	}
+	if len(names) != 4 || names[0] != "a" || names[1] != "b" || names[2] != "c" || names[3] != "new" {
+		t.Fatalf("expected streams in prior order with unselected and new streams kept, got %v", names)
+	}
+	if merged[0].SourceSchema.JsonSchema.ValueString() != `{"type":"object"}` {
+		t.Fatalf("expected the JSON schema to be kept, got %s", merged[0].SourceSchema.JsonSchema)
+	}
+}
+
+func TestGetCommonConnectionFieldsCapabilities(t *testing.T) {
+	data := ConnectionModel{Status: types.StringValue("active"), BreakingChange: types.BoolValue(false)}
+
+	fields := getCommonConnectionFields(data, apiclient.Capabilities{Version: "0.40.4"})
+	if fields.BreakingChange != nil {
+		t.Fatalf("expected breakingChange to be left out for Airbyte 0.40.4, got %t", *fields.BreakingChange)
+	}
+	fields = getCommonConnectionFields(data, apiclient.Capabilities{Version: apiclient.CapabilityBreakingChange.MinVersion})
+	if fields.BreakingChange == nil {
+		t.Fatalf("expected breakingChange to be sent")
+	}
+}
+
 func TestAccResourceConnection(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
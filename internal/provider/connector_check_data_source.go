@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource              = &ConnectorCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConnectorCheckDataSource{}
+)
+
+func NewConnectorCheckDataSource() datasource.DataSource {
+	return &ConnectorCheckDataSource{}
+}
+
+// ConnectorCheckDataSource defines the data source implementation.
+type ConnectorCheckDataSource struct {
+	client             apiclient.ConnectorsAPI
+	defaultWorkspaceId string
+}
+
+// ConnectorCheckModel describes the data source data model.
+type ConnectorCheckModel struct {
+	Id                      types.String `tfsdk:"id"`
+	SourceDefinitionId      types.String `tfsdk:"source_definition_id"`
+	DestinationDefinitionId types.String `tfsdk:"destination_definition_id"`
+	SourceId                types.String `tfsdk:"source_id"`
+	DestinationId           types.String `tfsdk:"destination_id"`
+	WorkspaceId             types.String `tfsdk:"workspace_id"`
+	ConnectionConfiguration types.String `tfsdk:"connection_configuration"`
+	Status                  types.String `tfsdk:"status"`
+	Message                 types.String `tfsdk:"message"`
+	JobId                   types.String `tfsdk:"job_id"`
+	Logs                    types.List   `tfsdk:"logs"`
+}
+
+func (d *ConnectorCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_check"
+}
+
+func (d *ConnectorCheckDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Check whether Airbyte can connect with a connector configuration, either a new one for " +
+			"a Source or Destination Definition, or that of an existing Source or Destination. A failed check " +
+			"doesn't fail the read, its `status` is `failed`.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Job ID of the check, which runs again on every read",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"source_definition_id": {
+				Description: "Source Definition to check connection_configuration with",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ExactlyOneOf(
+						path.MatchRoot("destination_definition_id"),
+						path.MatchRoot("source_id"),
+						path.MatchRoot("destination_id"),
+					),
+					schemavalidator.AlsoRequires(path.MatchRoot("connection_configuration")),
+				},
+			},
+			"destination_definition_id": {
+				Description: "Destination Definition to check connection_configuration with",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("connection_configuration")),
+				},
+			},
+			"source_id": {
+				Description: "Existing Source to check the saved configuration of",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"destination_id": {
+				Description: "Existing Destination to check the saved configuration of",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"workspace_id": {
+				Description: "Workspace ID to check connection_configuration in " +
+					"(Default: the default_workspace_id or default_workspace_slug of the provider)",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_configuration": {
+				Description: "Connection Configuration to check, required with a definition ID",
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(path.MatchRoot("source_id"), path.MatchRoot("destination_id")),
+				},
+			},
+			"status": {
+				Description: "Outcome of the check, 'succeeded' or 'failed'",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"message": {
+				Description: "Message of the check, explaining why it failed",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"job_id": {
+				Description: "Job ID of the check",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"logs": {
+				Description: "Log lines of the check job, if Airbyte returns them",
+				Type:        types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (d *ConnectorCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.Client
+	d.defaultWorkspaceId = data.DefaultWorkspaceId
+}
+
+func (d *ConnectorCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ConnectorCheckModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := config
+	var checkResponse *apiclient.CheckConnectionResponse
+	var err error
+	switch {
+	case !config.SourceId.IsNull():
+		checkResponse, err = d.client.CheckConnector(ctx, config.SourceId.ValueString(), apiclient.SourceType)
+	case !config.DestinationId.IsNull():
+		checkResponse, err = d.client.CheckConnector(ctx, config.DestinationId.ValueString(), apiclient.DestinationType)
+	default:
+		workspaceId := config.WorkspaceId.ValueString()
+		if config.WorkspaceId.IsNull() {
+			workspaceId = d.defaultWorkspaceId
+		}
+		if workspaceId == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("workspace_id"),
+				"Missing Workspace ID",
+				"Set workspace_id, or default_workspace_id or default_workspace_slug in the provider configuration.",
+			)
+			return
+		}
+		state.WorkspaceId = types.StringValue(workspaceId)
+
+		newConnector := apiclient.NewConnector{
+			WorkspaceIdBody: apiclient.WorkspaceIdBody{WorkspaceId: workspaceId},
+			CommonConnectorFields: apiclient.CommonConnectorFields{
+				ConnectionConfiguration: json.RawMessage(config.ConnectionConfiguration.ValueString()),
+			},
+		}
+		t := apiclient.SourceType
+		if config.SourceDefinitionId.IsNull() {
+			t = apiclient.DestinationType
+			newConnector.DestinationDefinitionId = config.DestinationDefinitionId.ValueString()
+		} else {
+			newConnector.SourceDefinitionId = config.SourceDefinitionId.ValueString()
+		}
+		checkResponse, err = d.client.CheckNewConnector(ctx, newConnector, t)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check the connector, got error: %s", err))
+		return
+	}
+
+	logs := []attr.Value{}
+	if checkResponse.JobInfo.Logs != nil {
+		for _, line := range checkResponse.JobInfo.Logs.LogLines {
+			logs = append(logs, types.StringValue(line))
+		}
+	}
+	state.Id = types.StringValue(checkResponse.JobInfo.Id)
+	state.Status = types.StringValue(checkResponse.Status)
+	state.Message = types.StringValue(checkResponse.Message)
+	state.JobId = types.StringValue(checkResponse.JobInfo.Id)
+	state.Logs = types.ListValueMust(types.StringType, logs)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
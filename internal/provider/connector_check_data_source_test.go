@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+// fakeConnectorChecks fails checks of new configurations and passes those of existing connectors
+type fakeConnectorChecks struct {
+	apiclient.ConnectorsAPI
+	checked []apiclient.NewConnector
+}
+
+func (f *fakeConnectorChecks) CheckNewConnector(ctx context.Context, connector apiclient.NewConnector, t apiclient.ConnectorType) (*apiclient.CheckConnectionResponse, error) {
+	f.checked = append(f.checked, connector)
+	return &apiclient.CheckConnectionResponse{
+		Status:  "failed",
+		Message: "Could not connect",
+		JobInfo: apiclient.JobInfo{Id: "1", Logs: &apiclient.JobLog{LogLines: []string{"Connection refused"}}},
+	}, nil
+}
+
+func (f *fakeConnectorChecks) CheckConnector(ctx context.Context, connectorId string, t apiclient.ConnectorType) (*apiclient.CheckConnectionResponse, error) {
+	return &apiclient.CheckConnectionResponse{Status: "succeeded", JobInfo: apiclient.JobInfo{Id: "2"}}, nil
+}
+
+func TestConnectorCheckDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeConnectorChecks{}
+	d := &ConnectorCheckDataSource{client: client, defaultWorkspaceId: "w1"}
+	schema, diags := d.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	read := func(config ConnectorCheckModel) ConnectorCheckModel {
+		state := tfsdk.State{Schema: schema}
+		config.Logs = types.ListNull(types.StringType)
+		if diags := state.Set(ctx, config); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		resp := &datasource.ReadResponse{State: state}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schema, Raw: state.Raw}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		var data ConnectorCheckModel
+		resp.State.Get(ctx, &data)
+		return data
+	}
+
+	data := read(ConnectorCheckModel{
+		SourceDefinitionId:      types.StringValue("d1"),
+		ConnectionConfiguration: types.StringValue(`{"host": "db.internal"}`),
+	})
+	if data.Status.ValueString() != "failed" || data.Message.ValueString() != "Could not connect" || data.JobId.ValueString() != "1" || len(data.Logs.Elements()) != 1 {
+		t.Fatalf("expected the failed check, got %+v", data)
+	}
+	if data.WorkspaceId.ValueString() != "w1" || len(client.checked) != 1 || client.checked[0].WorkspaceId != "w1" || client.checked[0].SourceDefinitionId != "d1" {
+		t.Fatalf("expected the configuration to be checked in the default workspace, got %+v", client.checked)
+	}
+
+	data = read(ConnectorCheckModel{DestinationId: types.StringValue("dst1")})
+	if data.Status.ValueString() != "succeeded" || data.Id.ValueString() != "2" || len(data.Logs.Elements()) != 0 {
+		t.Fatalf("expected the succeeded check, got %+v", data)
+	}
+}
+
+func TestAccDataSourceConnectorCheck(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnectorCheck,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.airbyte_connector_check.new", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet("data.airbyte_connector_check.new", "job_id"),
+					resource.TestCheckResourceAttrPair("data.airbyte_connector_check.new", "workspace_id", "airbyte_workspace.test", "id"),
+					resource.TestCheckResourceAttr("data.airbyte_connector_check.existing", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet("data.airbyte_connector_check.existing", "job_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceConnectorCheck = `
+resource "airbyte_workspace" "test" {
+  name = "test_workspace"
+}
+
+resource "airbyte_source_definition" "test" {
+  workspace_id = airbyte_workspace.test.id
+  name = "test_source_definition"
+  docker_repository = "eabrouwer3/airbyte-test-data-source"
+  docker_image_tag = "0.0.1"
+  documentation_url = "https://github.com/eabrouwer3/airbyte-test-data-source"
+}
+
+resource "airbyte_source" "test" {
+  definition_id = airbyte_source_definition.test.id
+  workspace_id = airbyte_workspace.test.id
+  name = "test_source"
+  connection_configuration = jsonencode({})
+}
+
+data "airbyte_connector_check" "new" {
+  source_definition_id = airbyte_source_definition.test.id
+  workspace_id = airbyte_workspace.test.id
+  connection_configuration = jsonencode({})
+}
+
+data "airbyte_connector_check" "existing" {
+  source_id = airbyte_source.test.id
+}
+`
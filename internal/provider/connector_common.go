@@ -2,9 +2,16 @@ package provider
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"reflect"
+	"strings"
 )
 
 // ConnectorModel describes the data connector data model.
@@ -18,6 +25,10 @@ type ConnectorModel struct {
 	// TODO: Implement something like this: https://www.youtube.com/watch?v=N2oGuPb_CIM
 	//       See this ticket: https://github.com/hashicorp/terraform-plugin-framework/issues/147
 	ConnectionConfiguration types.String `tfsdk:"connection_configuration"`
+	CheckConnection         types.String `tfsdk:"check_connection"`
+	LastCheckStatus         types.String `tfsdk:"last_check_status"`
+	LastCheckMessage        types.String `tfsdk:"last_check_message"`
+	LastCheckJobId          types.String `tfsdk:"last_check_job_id"`
 }
 
 func FlattenConnector(connector *apiclient.Connector) (*ConnectorModel, error) {
@@ -48,3 +59,147 @@ func GetCommonConnectorFields(data ConnectorModel) apiclient.CommonConnectorFiel
 		ConnectionConfiguration: json.RawMessage(data.ConnectionConfiguration.ValueString()),
 	}
 }
+
+const (
+	checkConnectionEnforce = "enforce"
+	checkConnectionWarn    = "warn"
+	checkConnectionSkip    = "skip"
+)
+
+func checkConnectionAttribute(connectorType string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: fmt.Sprintf("How the connection configuration is checked before the %[1]s is saved. "+
+			"With 'enforce', a failed check fails the apply. With 'warn', it is reported as a warning and the %[1]s is "+
+			"saved anyway. With 'skip', there is no check. The public API doesn't check connectors before saving them, "+
+			"which is reported as a warning if this is set. Allowed Values: 'enforce' | 'warn' | 'skip' (Default: 'enforce')", connectorType),
+		Type:     types.StringType,
+		Optional: true,
+		Validators: []tfsdk.AttributeValidator{
+			stringvalidator.OneOf(checkConnectionEnforce, checkConnectionWarn, checkConnectionSkip),
+		},
+	}
+}
+
+// checkLogTailLines is how many lines of the log of a failed check are reported
+const checkLogTailLines = 20
+
+// checkConnector runs check as the check_connection mode of data asks and reports its failure.
+// It returns the response of the check, if it ran, and false if the connector must not be saved.
+func checkConnector(data ConnectorModel, connectorType string, check func() (*apiclient.CheckConnectionResponse, error), diags *diag.Diagnostics) (*apiclient.CheckConnectionResponse, bool) {
+	mode := data.CheckConnection.ValueString()
+	if mode == checkConnectionSkip {
+		return nil, true
+	}
+
+	var detail string
+	checkResponse, err := check()
+	var unsupportedErr *apiclient.UnsupportedError
+	if errors.As(err, &unsupportedErr) {
+		// The public API checks connectors itself. A check_connection set explicitly is reported as
+		// not applied, while the default doesn't warn on every apply.
+		if !data.CheckConnection.IsNull() {
+			diags.AddAttributeWarning(
+				path.Root("check_connection"),
+				"Connection Check Not Run",
+				fmt.Sprintf("check_connection is '%s', but %s. The %s was saved without the provider checking it.", mode, err, connectorType),
+			)
+		}
+		return nil, true
+	} else if err != nil {
+		detail = fmt.Sprintf("Could not check the %s configuration, unexpected error: %s.", connectorType, err)
+	} else if checkResponse.Status != "succeeded" {
+		detail = fmt.Sprintf("Airbyte could not connect with the %s configuration (jobId: %s, message: %s).",
+			connectorType, checkResponse.JobInfo.Id, checkResponse.Message)
+	} else {
+		return checkResponse, true
+	}
+
+	if mode == checkConnectionWarn {
+		detail += " The " + connectorType + " was saved anyway as check_connection is 'warn'."
+	}
+	if checkResponse != nil {
+		if tail := checkResponse.JobInfo.LogTail(checkLogTailLines); len(tail) > 0 {
+			detail += "\n\nLast lines of the job log:\n" + strings.Join(tail, "\n")
+		}
+	}
+
+	if mode == checkConnectionWarn {
+		diags.AddAttributeWarning(path.Root("connection_configuration"), "Connection Check Failed", detail)
+		return checkResponse, true
+	}
+	diags.AddAttributeError(path.Root("connection_configuration"), "Connection Check Failed", detail)
+	return checkResponse, false
+}
+
+// setLastCheck records the outcome of checkResponse in data, or nulls if there was no check
+func (data *ConnectorModel) setLastCheck(checkResponse *apiclient.CheckConnectionResponse) {
+	if checkResponse == nil {
+		data.LastCheckStatus = types.StringNull()
+		data.LastCheckMessage = types.StringNull()
+		data.LastCheckJobId = types.StringNull()
+		return
+	}
+	data.LastCheckStatus = types.StringValue(checkResponse.Status)
+	data.LastCheckMessage = types.StringValue(checkResponse.Message)
+	data.LastCheckJobId = types.StringValue(checkResponse.JobInfo.Id)
+}
+
+// maskedSecret is how Airbyte returns the secrets of connection configurations
+const maskedSecret = "**********"
+
+// readConnectionConfiguration returns the connection configuration Airbyte returned as actual,
+// taking masked secrets to be unchanged from prior, so prior is kept unless it really drifted.
+func readConnectionConfiguration(prior types.String, actual json.RawMessage) (types.String, error) {
+	if len(actual) == 0 || string(actual) == "null" {
+		return prior, nil
+	}
+
+	var actualValue interface{}
+	if err := json.Unmarshal(actual, &actualValue); err != nil {
+		return prior, fmt.Errorf("could not parse the connection configuration: %w", err)
+	}
+	var priorValue interface{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		// An invalid prior configuration just drifts
+		_ = json.Unmarshal([]byte(prior.ValueString()), &priorValue)
+	}
+
+	value := unmaskSecrets(actualValue, priorValue)
+	if reflect.DeepEqual(value, priorValue) {
+		return prior, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return prior, err
+	}
+	return types.StringValue(string(b)), nil
+}
+
+// unmaskSecrets returns actual with its masked secrets replaced by the values at the same paths in prior
+func unmaskSecrets(actual interface{}, prior interface{}) interface{} {
+	switch a := actual.(type) {
+	case string:
+		if a == maskedSecret && prior != nil {
+			return prior
+		}
+	case map[string]interface{}:
+		p, _ := prior.(map[string]interface{})
+		value := make(map[string]interface{}, len(a))
+		for k, v := range a {
+			value[k] = unmaskSecrets(v, p[k])
+		}
+		return value
+	case []interface{}:
+		p, _ := prior.([]interface{})
+		value := make([]interface{}, len(a))
+		for i, v := range a {
+			var pv interface{}
+			if i < len(p) {
+				pv = p[i]
+			}
+			value[i] = unmaskSecrets(v, pv)
+		}
+		return value
+	}
+	return actual
+}
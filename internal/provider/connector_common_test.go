@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+func TestCheckConnector(t *testing.T) {
+	failed := func() (*apiclient.CheckConnectionResponse, error) {
+		return &apiclient.CheckConnectionResponse{
+			Status:  "failed",
+			Message: "Could not connect",
+			JobInfo: apiclient.JobInfo{
+				Id:   "42",
+				Logs: &apiclient.JobLog{LogLines: []string{"Checking connection...", "Connection refused"}},
+			},
+		}, nil
+	}
+
+	for _, tc := range []struct {
+		mode     types.String
+		check    func() (*apiclient.CheckConnectionResponse, error)
+		save     bool
+		errors   int
+		warnings int
+	}{
+		{mode: types.StringNull(), check: failed, save: false, errors: 1},
+		{mode: types.StringValue("enforce"), check: failed, save: false, errors: 1},
+		{mode: types.StringValue("warn"), check: failed, save: true, warnings: 1},
+		{mode: types.StringValue("warn"), check: func() (*apiclient.CheckConnectionResponse, error) {
+			return nil, errors.New("timeout")
+		}, save: true, warnings: 1},
+		{mode: types.StringValue("skip"), check: nil, save: true},
+		{mode: types.StringValue("enforce"), check: func() (*apiclient.CheckConnectionResponse, error) {
+			return &apiclient.CheckConnectionResponse{Status: "succeeded"}, nil
+		}, save: true},
+		{mode: types.StringValue("enforce"), check: func() (*apiclient.CheckConnectionResponse, error) {
+			return nil, &apiclient.UnsupportedError{Operation: "checking connectors"}
+		}, save: true, warnings: 1},
+		{mode: types.StringNull(), check: func() (*apiclient.CheckConnectionResponse, error) {
+			return nil, &apiclient.UnsupportedError{Operation: "checking connectors"}
+		}, save: true},
+	} {
+		var diags diag.Diagnostics
+		_, save := checkConnector(ConnectorModel{CheckConnection: tc.mode}, "source", tc.check, &diags)
+		if save != tc.save || diags.ErrorsCount() != tc.errors || diags.WarningsCount() != tc.warnings {
+			t.Errorf("%s: expected save %t with %d errors and %d warnings, got %t and %v", tc.mode, tc.save, tc.errors, tc.warnings, save, diags)
+		}
+		for _, d := range diags {
+			if strings.Contains(d.Detail(), "Could not connect") && (!strings.Contains(d.Detail(), "jobId: 42") || !strings.HasSuffix(d.Detail(), "Connection refused")) {
+				t.Errorf("expected the job id and log tail in %q", d.Detail())
+			}
+		}
+	}
+}
+
+func TestReadConnectionConfiguration(t *testing.T) {
+	prior := types.StringValue(`{"host": "db.internal", "password": "secret", "tunnel": {"ssh_key": "key"}, "schemas": ["public"]}`)
+
+	for _, tc := range []struct {
+		actual   string
+		expected string
+	}{
+		// Masked secrets are taken to be unchanged
+		{actual: `{"host":"db.internal","password":"**********","tunnel":{"ssh_key":"**********"},"schemas":["public"]}`, expected: prior.ValueString()},
+		{actual: ``, expected: prior.ValueString()},
+		// Other changes drift, keeping the known secrets
+		{actual: `{"host":"db2.internal","password":"**********","tunnel":{"ssh_key":"**********"},"schemas":["public"]}`,
+			expected: `{"host":"db2.internal","password":"secret","schemas":["public"],"tunnel":{"ssh_key":"key"}}`},
+		{actual: `{"host":"db.internal","password":"**********","schemas":["public","app"]}`,
+			expected: `{"host":"db.internal","password":"secret","schemas":["public","app"]}`},
+	} {
+		value, err := readConnectionConfiguration(prior, json.RawMessage(tc.actual))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if value.ValueString() != tc.expected {
+			t.Errorf("expected %s for %s, got %s", tc.expected, tc.actual, value.ValueString())
+		}
+	}
+
+	// Imported connectors have no prior configuration to unmask secrets with
+	value, err := readConnectionConfiguration(types.StringNull(), json.RawMessage(`{"password":"**********"}`))
+	if err != nil || value.ValueString() != `{"password":"**********"}` {
+		t.Fatalf("expected the masked configuration, got %s, %v", value, err)
+	}
+}
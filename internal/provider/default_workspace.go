@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDefaultWorkspaceId plans workspace_id as defaultWorkspaceId when the configuration leaves it out.
+// With requiresReplace, a change of the default replaces the resource like a change of an explicit
+// workspace_id does, which RequiresReplace doesn't catch as the attribute isn't configured.
+func planDefaultWorkspaceId(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, defaultWorkspaceId string, requiresReplace bool) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	workspaceIdPath := path.Root("workspace_id")
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, workspaceIdPath, &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	if defaultWorkspaceId == "" {
+		resp.Diagnostics.AddAttributeError(
+			workspaceIdPath,
+			"Missing Workspace ID",
+			"Set workspace_id, or default_workspace_id or default_workspace_slug in the provider configuration.",
+		)
+		return
+	}
+
+	workspaceId := types.StringValue(defaultWorkspaceId)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, workspaceIdPath, workspaceId)...)
+
+	if requiresReplace && !req.State.Raw.IsNull() {
+		var prior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, workspaceIdPath, &prior)...)
+		if !prior.Equal(workspaceId) {
+			resp.RequiresReplace = append(resp.RequiresReplace, workspaceIdPath)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +16,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &DestinationDefinitionResource{}
 var _ resource.ResourceWithImportState = &DestinationDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &DestinationDefinitionResource{}
 
 func NewDestinationDefinitionResource() resource.Resource {
 	return &DestinationDefinitionResource{}
@@ -22,7 +24,8 @@ func NewDestinationDefinitionResource() resource.Resource {
 
 // DestinationDefinitionResource defines the resource implementation.
 type DestinationDefinitionResource struct {
-	client *apiclient.ApiClient
+	client             apiclient.ConnectorDefinitionsAPI
+	defaultWorkspaceId string
 }
 
 func (r *DestinationDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,9 +47,10 @@ func (r *DestinationDefinitionResource) GetSchema(ctx context.Context) (tfsdk.Sc
 				},
 			},
 			"workspace_id": {
-				Description: "Workspace ID",
+				Description: "Workspace ID (Default: the default_workspace_id or default_workspace_slug of the provider)",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": {
 				Description: "Destination Definition Name",
@@ -166,18 +170,23 @@ func (r *DestinationDefinitionResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Destination Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.defaultWorkspaceId = data.DefaultWorkspaceId
+}
+
+func (r *DestinationDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultWorkspaceId(ctx, req, resp, r.defaultWorkspaceId, false)
 }
 
 func (r *DestinationDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -197,11 +206,14 @@ func (r *DestinationDefinitionResource) Create(ctx context.Context, req resource
 		DestinationDefinition: &commonFields,
 	}
 
-	destinationDefinition, err := r.client.CreateConnectorDefinition(newDestinationDefinition, apiclient.DestinationType)
+	destinationDefinition, err := r.client.CreateConnectorDefinition(ctx, newDestinationDefinition, apiclient.DestinationType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorDefinitionPropertyPaths,
 			"Error creating Destination Definition",
-			"Could not create Destination Definition, unexpected error: "+err.Error(),
+			"Could not create Destination Definition, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -230,8 +242,13 @@ func (r *DestinationDefinitionResource) Read(ctx context.Context, req resource.R
 
 	destinationDefinitionId := plan.Id.ValueString()
 
-	destinationDefinition, err := r.client.GetConnectorDefinitionById(destinationDefinitionId, apiclient.DestinationType)
+	destinationDefinition, err := r.client.GetConnectorDefinitionById(ctx, destinationDefinitionId, apiclient.DestinationType)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Destination Definition, got error: %s", err))
 		return
 	}
@@ -263,11 +280,14 @@ func (r *DestinationDefinitionResource) Update(ctx context.Context, req resource
 		ResourceRequirements: getResourceRequirementFields(plan),
 	}
 
-	destinationDefinition, err := r.client.UpdateConnectorDefinition(updatedDestinationDefinition, apiclient.DestinationType)
+	destinationDefinition, err := r.client.UpdateConnectorDefinition(ctx, updatedDestinationDefinition, apiclient.DestinationType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorDefinitionPropertyPaths,
 			"Error updating Destination Definition",
-			"Could not update Destination Definition, unexpected error: "+err.Error(),
+			"Could not update Destination Definition, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -288,7 +308,7 @@ func (r *DestinationDefinitionResource) Delete(ctx context.Context, req resource
 	}
 
 	destinationDefinitionId := state.Id.ValueString()
-	err := r.client.DeleteConnectorDefinition(destinationDefinitionId, apiclient.DestinationType)
+	err := r.client.DeleteConnectorDefinition(ctx, destinationDefinitionId, apiclient.DestinationType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Destination Definition",
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,6 +15,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &DestinationResource{}
 var _ resource.ResourceWithImportState = &DestinationResource{}
+var _ resource.ResourceWithModifyPlan = &DestinationResource{}
 
 func NewDestinationResource() resource.Resource {
 	return &DestinationResource{}
@@ -21,7 +23,8 @@ func NewDestinationResource() resource.Resource {
 
 // DestinationResource defines the resource implementation.
 type DestinationResource struct {
-	client *apiclient.ApiClient
+	client             apiclient.ConnectorsAPI
+	defaultWorkspaceId string
 }
 
 func (r *DestinationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,9 +54,10 @@ func (r *DestinationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag
 				},
 			},
 			"workspace_id": {
-				Description: "Workspace ID",
+				Description: "Workspace ID (Default: the default_workspace_id or default_workspace_slug of the provider)",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					resource.RequiresReplace(),
 				},
@@ -79,6 +83,22 @@ func (r *DestinationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag
 				Type:        types.StringType,
 				Computed:    true,
 			},
+			"check_connection": checkConnectionAttribute("destination"),
+			"last_check_status": {
+				Description: "Status of the last check of the Destination configuration, 'succeeded' or 'failed'. Null if check_connection is 'skip'",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"last_check_message": {
+				Description: "Message of the last check of the Destination configuration",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"last_check_job_id": {
+				Description: "Job ID of the last check of the Destination configuration",
+				Type:        types.StringType,
+				Computed:    true,
+			},
 		},
 	}, nil
 }
@@ -89,18 +109,23 @@ func (r *DestinationResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Destination Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.defaultWorkspaceId = data.DefaultWorkspaceId
+}
+
+func (r *DestinationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultWorkspaceId(ctx, req, resp, r.defaultWorkspaceId, true)
 }
 
 func (r *DestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -122,11 +147,22 @@ func (r *DestinationResource) Create(ctx context.Context, req resource.CreateReq
 		CommonConnectorFields: GetCommonConnectorFields(plan),
 	}
 
-	destination, err := r.client.CreateConnector(newDestination, apiclient.DestinationType)
+	checkNew := func() (*apiclient.CheckConnectionResponse, error) {
+		return r.client.CheckNewConnector(ctx, newDestination, apiclient.DestinationType)
+	}
+	checkResponse, ok := checkConnector(plan, "destination", checkNew, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	destination, err := r.client.CreateConnector(ctx, newDestination, apiclient.DestinationType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorPropertyPaths,
 			"Error creating Destination",
-			"Could not create Destination, unexpected error: "+err.Error(),
+			"Could not create Destination, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -140,6 +176,8 @@ func (r *DestinationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 	state.ConnectionConfiguration = plan.ConnectionConfiguration
+	state.CheckConnection = plan.CheckConnection
+	state.setLastCheck(checkResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -155,8 +193,13 @@ func (r *DestinationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	destinationId := plan.Id.ValueString()
 
-	destination, err := r.client.GetConnectorById(destinationId, apiclient.DestinationType)
+	destination, err := r.client.GetConnectorById(ctx, destinationId, apiclient.DestinationType)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Destination, got error: %s", err))
 		return
 	}
@@ -169,7 +212,18 @@ func (r *DestinationResource) Read(ctx context.Context, req resource.ReadRequest
 		)
 		return
 	}
-	state.ConnectionConfiguration = plan.ConnectionConfiguration
+	state.ConnectionConfiguration, err = readConnectionConfiguration(plan.ConnectionConfiguration, destination.ConnectionConfiguration)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Destination, got error: %s", err),
+		)
+		return
+	}
+	state.CheckConnection = plan.CheckConnection
+	state.LastCheckStatus = plan.LastCheckStatus
+	state.LastCheckMessage = plan.LastCheckMessage
+	state.LastCheckJobId = plan.LastCheckJobId
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -188,11 +242,22 @@ func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateReq
 		CommonConnectorFields: GetCommonConnectorFields(plan),
 	}
 
-	destination, err := r.client.UpdateConnector(updatedDestination, apiclient.DestinationType)
+	checkUpdated := func() (*apiclient.CheckConnectionResponse, error) {
+		return r.client.CheckUpdatedConnector(ctx, updatedDestination, apiclient.DestinationType)
+	}
+	checkResponse, ok := checkConnector(plan, "destination", checkUpdated, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	destination, err := r.client.UpdateConnector(ctx, updatedDestination, apiclient.DestinationType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorPropertyPaths,
 			"Error updating destination",
-			"Could not update Destination, unexpected error: "+err.Error(),
+			"Could not update Destination, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -206,6 +271,8 @@ func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 	state.ConnectionConfiguration = plan.ConnectionConfiguration
+	state.CheckConnection = plan.CheckConnection
+	state.setLastCheck(checkResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -220,7 +287,7 @@ func (r *DestinationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	destinationId := state.Id.ValueString()
-	err := r.client.DeleteConnector(destinationId, apiclient.DestinationType)
+	err := r.client.DeleteConnector(ctx, destinationId, apiclient.DestinationType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating destination",
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &OperationResource{}
 var _ resource.ResourceWithImportState = &OperationResource{}
+var _ resource.ResourceWithModifyPlan = &OperationResource{}
 
 func NewOperationResource() resource.Resource {
 	return &OperationResource{}
@@ -24,7 +26,9 @@ func NewOperationResource() resource.Resource {
 
 // OperationResource defines the resource implementation.
 type OperationResource struct {
-	client *apiclient.ApiClient
+	client             apiclient.OperationsAPI
+	capabilities       apiclient.Capabilities
+	defaultWorkspaceId string
 }
 
 func (r *OperationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,9 +50,10 @@ func (r *OperationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 				},
 			},
 			"workspace_id": {
-				Description: "Workspace ID",
+				Description: "Workspace ID (Default: the default_workspace_id or default_workspace_slug of the provider)",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					resource.RequiresReplace(),
 				},
@@ -153,18 +158,40 @@ func (r *OperationResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.defaultWorkspaceId = data.DefaultWorkspaceId
+	r.capabilities = data.Client.Capabilities()
+}
+
+func (r *OperationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultWorkspaceId(ctx, req, resp, r.defaultWorkspaceId, true)
+
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var operatorType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("operator_type"), &operatorType)...)
+	r.checkCapabilities(operatorType, &resp.Diagnostics)
+}
+
+// checkCapabilities reports the parts of the operation the server's version doesn't support, at plan
+// time, so the apply doesn't fail partway through
+func (r *OperationResource) checkCapabilities(operatorType types.String, diags *diag.Diagnostics) {
+	if operatorType.ValueString() == "webhook" && !r.capabilities.Supports(apiclient.CapabilityWebhookOperations) {
+		addUnsupportedAttributeError(diags, path.Root("operator_type"), `operator_type = "webhook"`, r.capabilities, apiclient.CapabilityWebhookOperations)
+	}
 }
 
 func (r *OperationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -183,7 +210,7 @@ func (r *OperationResource) Create(ctx context.Context, req resource.CreateReque
 		CommonOperationFields: GetCommonOperationFields(plan),
 	}
 
-	checkResponse, err := r.client.CheckOperation(newOperation.OperatorConfiguration)
+	checkResponse, err := r.client.CheckOperation(ctx, newOperation.OperatorConfiguration)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error checking operation",
@@ -198,11 +225,14 @@ func (r *OperationResource) Create(ctx context.Context, req resource.CreateReque
 		)
 		return
 	}
-	operation, err := r.client.CreateOperation(newOperation)
+	operation, err := r.client.CreateOperation(ctx, newOperation)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			operationPropertyPaths,
 			"Error creating operation",
-			"Could not create operation, unexpected error: "+err.Error(),
+			"Could not create operation, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -223,8 +253,13 @@ func (r *OperationResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	operationId := state.Id.ValueString()
 
-	operation, err := r.client.GetOperationById(operationId)
+	operation, err := r.client.GetOperationById(ctx, operationId)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read operation, got error: %s", err))
 		return
 	}
@@ -250,11 +285,14 @@ func (r *OperationResource) Update(ctx context.Context, req resource.UpdateReque
 		CommonOperationFields: GetCommonOperationFields(plan),
 	}
 
-	operation, err := r.client.UpdateOperation(updatedOperation)
+	operation, err := r.client.UpdateOperation(ctx, updatedOperation)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			operationPropertyPaths,
 			"Error updating operation",
-			"Could not update operation, unexpected error: "+err.Error(),
+			"Could not update operation, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -274,7 +312,7 @@ func (r *OperationResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	operationId := state.Id.ValueString()
-	err := r.client.DeleteOperation(operationId)
+	err := r.client.DeleteOperation(ctx, operationId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating operation",
@@ -1,12 +1,67 @@
 package provider
 
 import (
+	"context"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
 
+func TestOperationResourceCheckCapabilities(t *testing.T) {
+	webhook := types.StringValue("webhook")
+
+	var diags diag.Diagnostics
+	r := OperationResource{capabilities: apiclient.Capabilities{Version: "0.40.4"}}
+	r.checkCapabilities(webhook, &diags)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "requires Airbyte >= "+apiclient.CapabilityWebhookOperations.MinVersion) {
+		t.Fatalf("expected a version error, got: %v", diags)
+	}
+
+	// Servers of unknown version are trusted to support everything
+	for _, version := range []string{"", apiclient.CapabilityWebhookOperations.MinVersion} {
+		var diags diag.Diagnostics
+		r := OperationResource{capabilities: apiclient.Capabilities{Version: version}}
+		r.checkCapabilities(webhook, &diags)
+		if diags.HasError() {
+			t.Fatalf("version %q: unexpected errors: %v", version, diags)
+		}
+	}
+}
+
+func TestOperationResourceModifyPlanCapabilities(t *testing.T) {
+	ctx := context.Background()
+	r := &OperationResource{capabilities: apiclient.Capabilities{Version: "0.40.4"}}
+	schema, diags := r.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	config := tfsdk.State{Schema: schema}
+	config.Set(ctx, OperationModel{
+		WorkspaceId:  types.StringValue("w1"),
+		Name:         types.StringValue("notify"),
+		OperatorType: types.StringValue("webhook"),
+	})
+	plan := tfsdk.Plan{Schema: schema, Raw: config.Raw}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: config.Raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+	}, resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), "requires Airbyte >= ") {
+		t.Fatalf("expected a version error at plan time, got: %v", resp.Diagnostics)
+	}
+}
+
 func TestAccResourceNormalizationOperation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
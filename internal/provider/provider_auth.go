@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	authModeBasic             = "basic"
+	authModeApiKey            = "api_key"
+	authModeBearerToken       = "bearer_token"
+	authModeClientCredentials = "client_credentials"
+	authModeExec              = "exec"
+)
+
+// authModel describes the provider's auth block.
+type authModel struct {
+	Mode         types.String `tfsdk:"mode"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Token        types.String `tfsdk:"token"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	TokenUrl     types.String `tfsdk:"token_url"`
+	Exec         *execModel   `tfsdk:"exec"`
+}
+
+type execModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
+}
+
+func authSchemaBlock() tfsdk.Block {
+	return tfsdk.Block{
+		MarkdownDescription: "How to authenticate against the Airbyte API. Defaults to `basic` auth with the " +
+			"top-level `username` and `password`.",
+		NestingMode: tfsdk.BlockNestingModeSingle,
+		Attributes: map[string]tfsdk.Attribute{
+			"mode": {
+				MarkdownDescription: "Allowed Values: `basic` | `api_key` | `bearer_token` | `client_credentials` | `exec`. Can also be set " +
+					"with the AIRBYTE_AUTH_MODE environment variable. If unset, it is inferred from the credentials set in the " +
+					"configuration, then from the environment variables.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.OneOf(authModeBasic, authModeApiKey, authModeBearerToken, authModeClientCredentials, authModeExec),
+				},
+			},
+			"username": {
+				Description: "Username for basic auth (Env: AIRBYTE_USERNAME)",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"password": {
+				Description: "Password for basic auth (Env: AIRBYTE_PASSWORD)",
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_key": {
+				Description: "API key for api_key auth, as issued by Airbyte Cloud for its public API (Env: AIRBYTE_API_KEY)",
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token": {
+				Description: "Token for bearer_token auth (Env: AIRBYTE_BEARER_TOKEN)",
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_id": {
+				Description: "Application client ID for client_credentials auth (Env: AIRBYTE_CLIENT_ID)",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"client_secret": {
+				Description: "Application client secret for client_credentials auth (Env: AIRBYTE_CLIENT_SECRET)",
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token_url": {
+				Description: "URL to request client_credentials access tokens from (Env: AIRBYTE_TOKEN_URL, " +
+					"Default: <host_url>/api/v1/applications/token, or <host_url>/v1/applications/token with the public API)",
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"exec": {
+				MarkdownDescription: "Command to run for exec auth, similar to kubectl's exec credential plugins. It must " +
+					"print a JSON object on stdout with a `token` to send as a bearer token and/or `headers` to set on " +
+					"every request, plus an optional RFC 3339 `expiration_timestamp` after which it is run again.",
+				NestingMode: tfsdk.BlockNestingModeSingle,
+				Attributes: map[string]tfsdk.Attribute{
+					"command": {
+						Description: "Command to run (Env: AIRBYTE_EXEC_COMMAND)",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+					"args": {
+						Description: "Arguments to pass to the command (Env: AIRBYTE_EXEC_ARGS, space separated)",
+						Type:        types.ListType{ElemType: types.StringType},
+						Optional:    true,
+					},
+					"env": {
+						Description: "Additional environment variables to run the command with",
+						Type:        types.MapType{ElemType: types.StringType},
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// configValueOrEnv returns the configured value if set, otherwise the environment variable env,
+// otherwise defaultValue.
+func configValueOrEnv(v types.String, env string, defaultValue string) string {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueString()
+	}
+	if envValue, ok := os.LookupEnv(env); ok {
+		return envValue
+	}
+	return defaultValue
+}
+
+// int64ValueOrEnv is configValueOrEnv for the number at p, reporting an env value that isn't a number.
+func int64ValueOrEnv(v types.Int64, env string, defaultValue int64, p path.Path, diags *diag.Diagnostics) int64 {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueInt64()
+	}
+	if envValue, ok := os.LookupEnv(env); ok && envValue != "" {
+		n, err := strconv.ParseInt(envValue, 10, 64)
+		if err != nil {
+			diags.AddAttributeError(
+				p,
+				"Invalid "+env,
+				fmt.Sprintf("%s must be a number, got: %s", env, envValue),
+			)
+			return defaultValue
+		}
+		return n
+	}
+	return defaultValue
+}
+
+func getAuthenticator(data AirbyteProviderModel, diags *diag.Diagnostics) apiclient.Authenticator {
+	var auth authModel
+	if data.Auth != nil {
+		auth = *data.Auth
+	}
+
+	token := configValueOrEnv(auth.Token, "AIRBYTE_BEARER_TOKEN", "")
+	apiKey := configValueOrEnv(auth.ApiKey, "AIRBYTE_API_KEY", "")
+	clientId := configValueOrEnv(auth.ClientId, "AIRBYTE_CLIENT_ID", "")
+
+	var execCfg execModel
+	if auth.Exec != nil {
+		execCfg = *auth.Exec
+	}
+	execCommand := configValueOrEnv(execCfg.Command, "AIRBYTE_EXEC_COMMAND", "")
+
+	// The mode is inferred from the configuration before the environment, so variables meant for
+	// another mode, e.g. in a CI runner, don't override credentials configured in HCL
+	mode := auth.Mode.ValueString()
+	if mode == "" {
+		mode = configuredAuthMode(data, auth, execCfg)
+	}
+	if mode == "" {
+		mode = configValueOrEnv(auth.Mode, "AIRBYTE_AUTH_MODE", "")
+	}
+	if mode == "" {
+		if execCommand != "" {
+			mode = authModeExec
+		} else if apiKey != "" {
+			mode = authModeApiKey
+		} else if token != "" {
+			mode = authModeBearerToken
+		} else if clientId != "" {
+			mode = authModeClientCredentials
+		} else {
+			mode = authModeBasic
+		}
+	}
+
+	switch mode {
+	case authModeBasic:
+		return getBasicAuth(data, auth, diags)
+	case authModeApiKey:
+		if apiKey == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("api_key"),
+				"Missing Airbyte API Key",
+				"The provider cannot create the Airbyte API client as there is a missing or empty value for the Airbyte API key. "+
+					"Set the api_key value in the auth block or use the AIRBYTE_API_KEY environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+			return nil
+		}
+		// The public API takes API keys as bearer tokens
+		return &apiclient.BearerTokenAuth{Token: apiKey}
+	case authModeBearerToken:
+		if token == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("token"),
+				"Missing Airbyte API Token",
+				"The provider cannot create the Airbyte API client as there is a missing or empty value for the Airbyte API bearer token. "+
+					"Set the token value in the auth block or use the AIRBYTE_BEARER_TOKEN environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+			return nil
+		}
+		return &apiclient.BearerTokenAuth{Token: token}
+	case authModeClientCredentials:
+		clientSecret := configValueOrEnv(auth.ClientSecret, "AIRBYTE_CLIENT_SECRET", "")
+		if clientId == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("client_id"),
+				"Missing Airbyte API Client ID",
+				"The provider cannot create the Airbyte API client as there is a missing or empty value for the Airbyte API client ID. "+
+					"Set the client_id value in the auth block or use the AIRBYTE_CLIENT_ID environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+		if clientSecret == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("client_secret"),
+				"Missing Airbyte API Client Secret",
+				"The provider cannot create the Airbyte API client as there is a missing or empty value for the Airbyte API client secret. "+
+					"Set the client_secret value in the auth block or use the AIRBYTE_CLIENT_SECRET environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+		if diags.HasError() {
+			return nil
+		}
+		return &apiclient.ClientCredentialsAuth{
+			ClientId:     clientId,
+			ClientSecret: clientSecret,
+			TokenURL:     configValueOrEnv(auth.TokenUrl, "AIRBYTE_TOKEN_URL", ""),
+		}
+	case authModeExec:
+		if execCommand == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("exec").AtName("command"),
+				"Missing Airbyte API Credential Helper",
+				"The provider cannot create the Airbyte API client as there is a missing or empty value for the credential helper command. "+
+					"Set the command value in the auth exec block or use the AIRBYTE_EXEC_COMMAND environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+			return nil
+		}
+		execAuth := apiclient.ExecAuth{
+			Command: execCommand,
+			Env:     make(map[string]string),
+		}
+		if !execCfg.Args.IsNull() {
+			for _, arg := range execCfg.Args.Elements() {
+				execAuth.Args = append(execAuth.Args, arg.(types.String).ValueString())
+			}
+		} else if args, ok := os.LookupEnv("AIRBYTE_EXEC_ARGS"); ok {
+			execAuth.Args = strings.Fields(args)
+		}
+		for k, v := range execCfg.Env.Elements() {
+			execAuth.Env[k] = v.(types.String).ValueString()
+		}
+		return &execAuth
+	default:
+		diags.AddAttributeError(
+			path.Root("auth").AtName("mode"),
+			"Invalid Airbyte API Auth Mode",
+			"The auth mode must be one of basic, api_key, bearer_token, client_credentials or exec, got: "+mode,
+		)
+		return nil
+	}
+}
+
+// hasCredentials reports whether data sets credentials of any auth mode
+func hasCredentials(data AirbyteProviderModel) bool {
+	var auth authModel
+	if data.Auth != nil {
+		auth = *data.Auth
+	}
+	var execCfg execModel
+	if auth.Exec != nil {
+		execCfg = *auth.Exec
+	}
+	return configuredAuthMode(data, auth, execCfg) != ""
+}
+
+// configuredAuthMode returns the mode of the credentials set in the configuration, or "" if there are none
+func configuredAuthMode(data AirbyteProviderModel, auth authModel, execCfg execModel) string {
+	switch {
+	case !execCfg.Command.IsNull():
+		return authModeExec
+	case !auth.ApiKey.IsNull():
+		return authModeApiKey
+	case !auth.Token.IsNull():
+		return authModeBearerToken
+	case !auth.ClientId.IsNull():
+		return authModeClientCredentials
+	case !auth.Username.IsNull() || !auth.Password.IsNull() || !data.Username.IsNull() || !data.Password.IsNull():
+		return authModeBasic
+	}
+	return ""
+}
+
+func getBasicAuth(data AirbyteProviderModel, auth authModel, diags *diag.Diagnostics) apiclient.Authenticator {
+	username := auth.Username
+	if username.IsNull() {
+		username = data.Username
+	}
+	password := auth.Password
+	if password.IsNull() {
+		password = data.Password
+	}
+
+	basicAuth := apiclient.BasicAuth{
+		Username: configValueOrEnv(username, "AIRBYTE_USERNAME", "airbyte"),
+		Password: configValueOrEnv(password, "AIRBYTE_PASSWORD", "password"),
+		Default: configValueOrEnv(username, "AIRBYTE_USERNAME", "") == "" &&
+			configValueOrEnv(password, "AIRBYTE_PASSWORD", "") == "",
+	}
+
+	if basicAuth.Username == "" {
+		diags.AddAttributeWarning(
+			path.Root("username"),
+			"Missing Airbyte API Username",
+			"There is a missing or empty value for the Airbyte API Username. This assumes authentication has been disabled for this Airbyte Instance."+
+				"If this is not true, set the username value in the configuration or use the AIRBYTE_USERNAME environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+	if basicAuth.Password == "" {
+		diags.AddAttributeWarning(
+			path.Root("password"),
+			"Blank Airbyte API Password",
+			"There is a missing or empty value for the Airbyte API Password. This assumes authentication has been disabled for this Airbyte Instance."+
+				"If this is not true, set the password value in the configuration or use the AIRBYTE_PASSWORD environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	return &basicAuth
+}
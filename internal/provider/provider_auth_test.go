@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+func TestGetAuthenticatorMode(t *testing.T) {
+	for _, env := range []string{"AIRBYTE_AUTH_MODE", "AIRBYTE_USERNAME", "AIRBYTE_PASSWORD", "AIRBYTE_EXEC_COMMAND", "AIRBYTE_CLIENT_SECRET"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("AIRBYTE_API_KEY", "env-api-key")
+	t.Setenv("AIRBYTE_BEARER_TOKEN", "env-token")
+	t.Setenv("AIRBYTE_CLIENT_ID", "env-client-id")
+
+	// Credentials configured in HCL win over variables of another mode in the environment
+	var diags diag.Diagnostics
+	auth := getAuthenticator(AirbyteProviderModel{
+		Username: types.StringValue("airbyte"),
+		Password: types.StringValue("hunter2"),
+	}, &diags)
+	if basic, ok := auth.(*apiclient.BasicAuth); diags.HasError() || !ok || basic.Password != "hunter2" {
+		t.Fatalf("expected basic auth from the configuration, got %#v, %v", auth, diags)
+	}
+
+	auth = getAuthenticator(AirbyteProviderModel{
+		Username: types.StringNull(),
+		Password: types.StringNull(),
+		Auth:     &authModel{Token: types.StringValue("hcl-token")},
+	}, &diags)
+	if bearer, ok := auth.(*apiclient.BearerTokenAuth); diags.HasError() || !ok || bearer.Token != "hcl-token" {
+		t.Fatalf("expected bearer auth from the configuration, got %#v, %v", auth, diags)
+	}
+
+	// Without credentials in HCL, the environment decides
+	auth = getAuthenticator(AirbyteProviderModel{Username: types.StringNull(), Password: types.StringNull()}, &diags)
+	if bearer, ok := auth.(*apiclient.BearerTokenAuth); diags.HasError() || !ok || bearer.Token != "env-api-key" {
+		t.Fatalf("expected the API key from the environment, got %#v, %v", auth, diags)
+	}
+}
+
+func TestDefaultBasicAuthKeepsAdditionalAuthorization(t *testing.T) {
+	for _, env := range []string{"AIRBYTE_AUTH_MODE", "AIRBYTE_USERNAME", "AIRBYTE_PASSWORD", "AIRBYTE_API_KEY", "AIRBYTE_BEARER_TOKEN", "AIRBYTE_CLIENT_ID", "AIRBYTE_EXEC_COMMAND"} {
+		t.Setenv(env, "")
+		os.Unsetenv(env)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer header-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"available": true}`)
+	}))
+	defer server.Close()
+
+	// additional_headers = { Authorization = "Bearer header-token" } without an auth block
+	var diags diag.Diagnostics
+	auth := getAuthenticator(AirbyteProviderModel{Username: types.StringNull(), Password: types.StringNull()}, &diags)
+	if basic, ok := auth.(*apiclient.BasicAuth); diags.HasError() || !ok || !basic.Default {
+		t.Fatalf("expected the default basic auth, got %#v, %v", auth, diags)
+	}
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := &apiclient.ApiClient{
+		HostURL:           server.URL,
+		Auth:              auth,
+		AdditionalHeaders: map[string]string{"Authorization": "Bearer header-token"},
+		HTTPClient:        httpClient,
+	}
+	if err := client.Check(context.Background()); err != nil {
+		t.Fatalf("expected the additional Authorization header to be sent, got: %s", err)
+	}
+
+	// Configured credentials still replace it
+	auth = getAuthenticator(AirbyteProviderModel{Username: types.StringValue("airbyte"), Password: types.StringValue("hunter2")}, &diags)
+	client.Auth = auth
+	if err := client.Check(context.Background()); err == nil {
+		t.Fatalf("expected the configured basic auth to replace the header")
+	}
+}
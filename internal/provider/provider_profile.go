@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// profilesFile is the file holding the profiles of the Airbyte instances the provider can be
+// configured with, e.g.
+//
+//	profiles:
+//	  staging:
+//	    host_url: https://airbyte.staging.example.com
+//	    auth:
+//	      client_id: my-application-client-id
+//	      client_secret: my-application-client-secret
+//	    timeout: 120
+type profilesFile struct {
+	Profiles map[string]profile `yaml:"profiles"`
+}
+
+// profile holds provider attributes, named as in the provider configuration
+type profile struct {
+	HostUrl              string            `yaml:"host_url"`
+	ApiFlavor            string            `yaml:"api_flavor"`
+	Username             string            `yaml:"username"`
+	Password             string            `yaml:"password"`
+	AdditionalHeaders    map[string]string `yaml:"additional_headers"`
+	Timeout              *int64            `yaml:"timeout"`
+	Auth                 *profileAuth      `yaml:"auth"`
+	CACertFile           string            `yaml:"ca_cert_file"`
+	CACertPem            string            `yaml:"ca_cert_pem"`
+	ClientCert           string            `yaml:"client_cert"`
+	ClientKey            string            `yaml:"client_key"`
+	InsecureSkipVerify   *bool             `yaml:"insecure_skip_verify"`
+	ProxyUrl             string            `yaml:"proxy_url"`
+	NoProxy              string            `yaml:"no_proxy"`
+	DefaultWorkspaceId   string            `yaml:"default_workspace_id"`
+	DefaultWorkspaceSlug string            `yaml:"default_workspace_slug"`
+}
+
+type profileAuth struct {
+	Mode         string       `yaml:"mode"`
+	Username     string       `yaml:"username"`
+	Password     string       `yaml:"password"`
+	Token        string       `yaml:"token"`
+	ApiKey       string       `yaml:"api_key"`
+	ClientId     string       `yaml:"client_id"`
+	ClientSecret string       `yaml:"client_secret"`
+	TokenUrl     string       `yaml:"token_url"`
+	Exec         *profileExec `yaml:"exec"`
+}
+
+type profileExec struct {
+	Command string            `yaml:"command"`
+	Args    []string          `yaml:"args"`
+	Env     map[string]string `yaml:"env"`
+}
+
+// profilesFilePath returns the path of the profiles file, AIRBYTE_CONFIG_FILE or ~/.airbyte/config.yaml
+func profilesFilePath() (string, error) {
+	if v, ok := os.LookupEnv("AIRBYTE_CONFIG_FILE"); ok && v != "" {
+		return v, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".airbyte", "config.yaml"), nil
+}
+
+// applyProfile fills the attributes data leaves unset with those of the selected profile, if any.
+// Attributes set in the configuration override the profile's, which override environment variables.
+func applyProfile(data *AirbyteProviderModel, diags *diag.Diagnostics) {
+	name := configValueOrEnv(data.Profile, "AIRBYTE_PROFILE", "")
+	if name == "" {
+		return
+	}
+
+	file, err := profilesFilePath()
+	if err == nil {
+		var p *profile
+		p, err = loadProfile(file, name)
+		if err == nil {
+			p.apply(data)
+			return
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("profile"),
+		"Invalid Airbyte Profile",
+		fmt.Sprintf("Could not load the profile %q: %s", name, err),
+	)
+}
+
+func loadProfile(file string, name string) (*profile, error) {
+	b, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("the profiles file %s does not exist. Set AIRBYTE_CONFIG_FILE to read another one", file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles profilesFile
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&profiles); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", file, err)
+	}
+
+	p, ok := profiles.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles.Profiles))
+		for n := range profiles.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%s has no such profile, only: %s", file, strings.Join(names, ", "))
+	}
+	return &p, nil
+}
+
+func (p *profile) apply(data *AirbyteProviderModel) {
+	// Credentials set in the configuration replace all of the profile's, which could otherwise
+	// choose another auth mode than theirs
+	configuredCredentials := hasCredentials(*data)
+
+	fillString(&data.HostUrl, p.HostUrl)
+	fillString(&data.ApiFlavor, p.ApiFlavor)
+	if !configuredCredentials {
+		fillString(&data.Username, p.Username)
+		fillString(&data.Password, p.Password)
+	}
+	fillStringMap(&data.AdditionalHeaders, p.AdditionalHeaders)
+	if data.Timeout.IsNull() && p.Timeout != nil {
+		data.Timeout = types.Int64Value(*p.Timeout)
+	}
+	fillString(&data.CACertFile, p.CACertFile)
+	fillString(&data.CACertPem, p.CACertPem)
+	fillString(&data.ClientCert, p.ClientCert)
+	fillString(&data.ClientKey, p.ClientKey)
+	if data.InsecureSkipVerify.IsNull() && p.InsecureSkipVerify != nil {
+		data.InsecureSkipVerify = types.BoolValue(*p.InsecureSkipVerify)
+	}
+	fillString(&data.ProxyUrl, p.ProxyUrl)
+	fillString(&data.NoProxy, p.NoProxy)
+	// A default workspace configured either way overrides the profile's
+	if data.DefaultWorkspaceId.IsNull() && data.DefaultWorkspaceSlug.IsNull() {
+		fillString(&data.DefaultWorkspaceId, p.DefaultWorkspaceId)
+		fillString(&data.DefaultWorkspaceSlug, p.DefaultWorkspaceSlug)
+	}
+
+	if p.Auth == nil || configuredCredentials {
+		return
+	}
+	if data.Auth == nil {
+		data.Auth = &authModel{}
+	}
+	auth := data.Auth
+	fillString(&auth.Mode, p.Auth.Mode)
+	fillString(&auth.Username, p.Auth.Username)
+	fillString(&auth.Password, p.Auth.Password)
+	fillString(&auth.Token, p.Auth.Token)
+	fillString(&auth.ApiKey, p.Auth.ApiKey)
+	fillString(&auth.ClientId, p.Auth.ClientId)
+	fillString(&auth.ClientSecret, p.Auth.ClientSecret)
+	fillString(&auth.TokenUrl, p.Auth.TokenUrl)
+
+	if p.Auth.Exec == nil {
+		return
+	}
+	if auth.Exec == nil {
+		auth.Exec = &execModel{
+			Args: types.ListNull(types.StringType),
+			Env:  types.MapNull(types.StringType),
+		}
+	}
+	fillString(&auth.Exec.Command, p.Auth.Exec.Command)
+	if auth.Exec.Args.IsNull() && p.Auth.Exec.Args != nil {
+		args := make([]attr.Value, 0, len(p.Auth.Exec.Args))
+		for _, arg := range p.Auth.Exec.Args {
+			args = append(args, types.StringValue(arg))
+		}
+		auth.Exec.Args = types.ListValueMust(types.StringType, args)
+	}
+	fillStringMap(&auth.Exec.Env, p.Auth.Exec.Env)
+}
+
+// fillString sets v to value if v is null and value isn't empty
+func fillString(v *types.String, value string) {
+	if v.IsNull() && value != "" {
+		*v = types.StringValue(value)
+	}
+}
+
+// fillStringMap sets v to value if v is null and value isn't empty
+func fillStringMap(v *types.Map, value map[string]string) {
+	if !v.IsNull() || len(value) == 0 {
+		return
+	}
+	elements := make(map[string]attr.Value, len(value))
+	for k, e := range value {
+		elements[k] = types.StringValue(e)
+	}
+	*v = types.MapValueMust(types.StringType, elements)
+}
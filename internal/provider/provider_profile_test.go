@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+func TestApplyProfile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(file, []byte(`
+profiles:
+  dev:
+    host_url: http://localhost:8000
+  staging:
+    host_url: https://airbyte.staging.example.com
+    additional_headers:
+      Host: airbyte.internal
+    timeout: 120
+    auth:
+      client_id: staging-client-id
+      client_secret: staging-client-secret
+`), 0600)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Setenv("AIRBYTE_CONFIG_FILE", file)
+	t.Setenv("AIRBYTE_PROFILE", "")
+
+	var diags diag.Diagnostics
+	data := AirbyteProviderModel{
+		Profile:           types.StringValue("staging"),
+		Timeout:           types.Int64Value(30),
+		HostUrl:           types.StringNull(),
+		AdditionalHeaders: types.MapNull(types.StringType),
+	}
+	applyProfile(&data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if data.HostUrl.ValueString() != "https://airbyte.staging.example.com" {
+		t.Errorf("expected the profile's host_url, got %s", data.HostUrl)
+	}
+	if data.Timeout.ValueInt64() != 30 {
+		t.Errorf("expected the configured timeout to override the profile's, got %s", data.Timeout)
+	}
+	if headers := data.AdditionalHeaders.Elements(); len(headers) != 1 || headers["Host"].(types.String).ValueString() != "airbyte.internal" {
+		t.Errorf("expected the profile's additional_headers, got %s", data.AdditionalHeaders)
+	}
+	if data.Auth == nil || data.Auth.ClientId.ValueString() != "staging-client-id" || !data.Auth.Mode.IsNull() {
+		t.Errorf("expected the profile's auth, got %+v", data.Auth)
+	}
+
+	// Credentials set in the configuration win over the profile's, whatever their auth mode
+	for _, env := range []string{"AIRBYTE_AUTH_MODE", "AIRBYTE_USERNAME", "AIRBYTE_PASSWORD", "AIRBYTE_API_KEY", "AIRBYTE_BEARER_TOKEN", "AIRBYTE_CLIENT_ID", "AIRBYTE_EXEC_COMMAND"} {
+		t.Setenv(env, "")
+		os.Unsetenv(env)
+	}
+	data = AirbyteProviderModel{
+		Profile:  types.StringValue("staging"),
+		Username: types.StringValue("airbyte"),
+		Password: types.StringValue("hunter2"),
+	}
+	applyProfile(&data, &diags)
+	if diags.HasError() || data.Auth != nil {
+		t.Fatalf("expected the profile's auth to be skipped, got %+v, %v", data.Auth, diags)
+	}
+	auth := getAuthenticator(data, &diags)
+	if basic, ok := auth.(*apiclient.BasicAuth); diags.HasError() || !ok || basic.Password != "hunter2" {
+		t.Fatalf("expected basic auth from the configuration, got %#v, %v", auth, diags)
+	}
+
+	// The profile can be selected with AIRBYTE_PROFILE
+	t.Setenv("AIRBYTE_PROFILE", "dev")
+	data = AirbyteProviderModel{Profile: types.StringNull()}
+	applyProfile(&data, &diags)
+	if diags.HasError() || data.HostUrl.ValueString() != "http://localhost:8000" || data.Auth != nil {
+		t.Fatalf("expected the dev profile, got %+v, %v", data, diags)
+	}
+
+	data = AirbyteProviderModel{Profile: types.StringValue("prod")}
+	applyProfile(&data, &diags)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "only: dev, staging") {
+		t.Fatalf("expected an unknown profile error, got %v", diags)
+	}
+
+	if err := os.WriteFile(file, []byte("profiles:\n  dev:\n    url: http://localhost:8000\n"), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	diags = nil
+	data = AirbyteProviderModel{Profile: types.StringValue("dev")}
+	applyProfile(&data, &diags)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "field url not found") {
+		t.Fatalf("expected an unknown attribute error, got %v", diags)
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGetProxy(t *testing.T) {
+	var proxied []string
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Proxy-Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")) {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+		proxied = append(proxied, r.URL.String())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxyServer.Close()
+
+	var diags diag.Diagnostics
+	proxy := getProxy(AirbyteProviderModel{
+		ProxyUrl: types.StringValue("http://user:secret@" + proxyServer.Listener.Addr().String()),
+		NoProxy:  types.StringValue("direct.internal"),
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	res, err := (&http.Client{Transport: &http.Transport{Proxy: proxy}}).Get("http://airbyte.internal:8000/api/v1/health")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || len(proxied) != 1 || proxied[0] != "http://airbyte.internal:8000/api/v1/health" {
+		t.Fatalf("expected the request to go through the proxy, got status %d and %v", res.StatusCode, proxied)
+	}
+
+	req, _ := http.NewRequest("GET", "http://direct.internal/api/v1/health", nil)
+	if u, err := proxy(req); err != nil || u != nil {
+		t.Fatalf("expected no proxy for no_proxy hosts, got %v, %v", u, err)
+	}
+
+	for _, invalid := range []string{"proxy.internal:3128", "ftp://proxy.internal"} {
+		var diags diag.Diagnostics
+		getProxy(AirbyteProviderModel{ProxyUrl: types.StringValue(invalid)}, &diags)
+		if !diags.HasError() {
+			t.Fatalf("expected an error for %q", invalid)
+		}
+	}
+
+	// Without settings the environment's proxy is used
+	var none diag.Diagnostics
+	if getProxy(AirbyteProviderModel{ProxyUrl: types.StringNull(), NoProxy: types.StringNull()}, &none) != nil {
+		t.Fatalf("expected no proxy function")
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"net/http"
+)
+
+const defaultRateLimitBurst = 1
+
+// rateLimitModel describes the provider's rate_limit block.
+type rateLimitModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+}
+
+func rateLimitSchemaBlock() tfsdk.Block {
+	return tfsdk.Block{
+		MarkdownDescription: "Limits the rate of requests sent to the Airbyte API, e.g. to stay within the quota of a gateway " +
+			"in front of it. Retries count against the limit. A 429 response halves the rate and pauses requests for as long " +
+			"as its `Retry-After` header asks, after which the rate recovers gradually.",
+		NestingMode: tfsdk.BlockNestingModeSingle,
+		Attributes: map[string]tfsdk.Attribute{
+			"requests_per_second": {
+				Description: "Average number of requests per second",
+				Type:        types.Float64Type,
+				Required:    true,
+				Validators: []tfsdk.AttributeValidator{
+					float64validator.AtLeast(0.001),
+				},
+			},
+			"burst": {
+				Description: "Number of requests that may be sent at once after a quiet period (Default: 1)",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// rateLimitTransport wraps transport with the rate limiter of the rate_limit block, if set.
+func rateLimitTransport(data AirbyteProviderModel, transport http.RoundTripper) http.RoundTripper {
+	if data.RateLimit == nil {
+		return transport
+	}
+
+	burst := int64(defaultRateLimitBurst)
+	if !data.RateLimit.Burst.IsNull() {
+		burst = data.RateLimit.Burst.ValueInt64()
+	}
+	limiter := apiclient.NewRateLimiter(data.RateLimit.RequestsPerSecond.ValueFloat64(), int(burst))
+	return limiter.Transport(transport)
+}
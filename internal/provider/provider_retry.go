@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryMinBackoff  = 1
+	defaultRetryMaxBackoff  = 30
+)
+
+// retryModel describes the provider's retry block.
+type retryModel struct {
+	MaxAttempts    types.Int64 `tfsdk:"max_attempts"`
+	MinBackoff     types.Int64 `tfsdk:"min_backoff"`
+	MaxBackoff     types.Int64 `tfsdk:"max_backoff"`
+	StatusCodes    types.List  `tfsdk:"status_codes"`
+	RetryCreates   types.Bool  `tfsdk:"retry_creates"`
+	RecoverCreates types.Bool  `tfsdk:"recover_creates"`
+}
+
+func retrySchemaBlock() tfsdk.Block {
+	return tfsdk.Block{
+		MarkdownDescription: "How failed requests to the Airbyte API are retried. Unless `retry_creates` is set, requests " +
+			"that create objects are only retried on 429 responses and 503 responses with a `Retry-After` header, which " +
+			"Airbyte rejected without acting on them. `Retry-After` headers of 429 and 503 responses are honored, up to " +
+			"`max_backoff`.",
+		NestingMode: tfsdk.BlockNestingModeSingle,
+		Attributes: map[string]tfsdk.Attribute{
+			"max_attempts": {
+				Description: "Maximum number of attempts per request, including the first one (Default: 5)",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_backoff": {
+				Description: "Minimum time to wait between attempts in seconds (Default: 1)",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_backoff": {
+				Description: "Maximum time to wait between attempts in seconds, also capping `Retry-After` headers (Default: 30)",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"status_codes": {
+				Description: "Response status codes to retry (Default: 429 and any 5xx status but 501)",
+				Type:        types.ListType{ElemType: types.Int64Type},
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					listvalidator.ValuesAre(int64validator.Between(100, 599)),
+				},
+			},
+			"retry_creates": {
+				MarkdownDescription: "Also retry requests that create objects after transport errors and any of the " +
+					"`status_codes`. A retried request may create a duplicate object if the failed attempt reached Airbyte " +
+					"(Default: `false`)",
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"recover_creates": {
+				MarkdownDescription: "When creating a source, destination, connection or workspace fails without a response " +
+					"after the request was sent, e.g. on a timeout, look for the object the request may have created anyway " +
+					"and adopt it if exactly one object with the planned name and parent IDs exists. Only supported with the " +
+					"`config` API flavor (Default: `false`)",
+				Type:     types.BoolType,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// getRecoverCreates returns whether the retry block enables recover_creates, which only the Config API
+// client implements. With the public API, it is rejected rather than silently ignored.
+func getRecoverCreates(data AirbyteProviderModel, flavor string, diags *diag.Diagnostics) bool {
+	if data.Retry == nil || !data.Retry.RecoverCreates.ValueBool() {
+		return false
+	}
+	if flavor == apiFlavorPublic {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("recover_creates"),
+			"Unsupported With the Public API",
+			"recover_creates is only supported with api_flavor = \"config\", as the provider can't look up the objects "+
+				"created by failed requests through the public API. Remove it, or use the Config API.",
+		)
+		return false
+	}
+	return true
+}
+
+// configureRetries applies the retry block to httpClient.
+func configureRetries(ctx context.Context, data AirbyteProviderModel, httpClient *retryablehttp.Client, diags *diag.Diagnostics) {
+	maxAttempts := int64(defaultRetryMaxAttempts)
+	minBackoff := int64(defaultRetryMinBackoff)
+	maxBackoff := int64(defaultRetryMaxBackoff)
+	policy := apiclient.RetryPolicy{}
+
+	if data.Retry != nil {
+		if !data.Retry.MaxAttempts.IsNull() {
+			maxAttempts = data.Retry.MaxAttempts.ValueInt64()
+		}
+		if !data.Retry.MinBackoff.IsNull() {
+			minBackoff = data.Retry.MinBackoff.ValueInt64()
+		}
+		if !data.Retry.MaxBackoff.IsNull() {
+			maxBackoff = data.Retry.MaxBackoff.ValueInt64()
+		}
+		if minBackoff > maxBackoff {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("min_backoff"),
+				"Invalid retry backoff",
+				"min_backoff must not be greater than max_backoff.",
+			)
+			return
+		}
+
+		var statusCodes []int64
+		diags.Append(data.Retry.StatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		if diags.HasError() {
+			return
+		}
+		for _, code := range statusCodes {
+			policy.StatusCodes = append(policy.StatusCodes, int(code))
+		}
+		policy.RetryCreates = data.Retry.RetryCreates.ValueBool()
+	}
+
+	httpClient.RetryMax = int(maxAttempts) - 1
+	httpClient.RetryWaitMin = time.Duration(minBackoff) * time.Second
+	httpClient.RetryWaitMax = time.Duration(maxBackoff) * time.Second
+	httpClient.CheckRetry = policy.CheckRetry
+	httpClient.Backoff = apiclient.RetryBackoff
+	httpClient.RequestLogHook = apiclient.RetryLogHook
+	// Hand the last response to the API client once retries are exhausted so it can return a typed error
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.Logger = nil
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGetRecoverCreates(t *testing.T) {
+	data := AirbyteProviderModel{Retry: &retryModel{RecoverCreates: types.BoolValue(true)}}
+
+	var diags diag.Diagnostics
+	if !getRecoverCreates(data, apiFlavorConfig, &diags) || diags.HasError() {
+		t.Fatalf("expected recover_creates with the Config API, got %v", diags)
+	}
+
+	// The public API client can't look up the objects of failed creates
+	if getRecoverCreates(data, apiFlavorPublic, &diags) || !diags.HasError() {
+		t.Fatalf("expected recover_creates to be rejected with the public API, got %v", diags)
+	}
+
+	diags = nil
+	if getRecoverCreates(AirbyteProviderModel{}, apiFlavorPublic, &diags) || diags.HasError() {
+		t.Fatalf("expected no recover_creates without a retry block, got %v", diags)
+	}
+}
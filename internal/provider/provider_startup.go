@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"os"
+	"strconv"
+	"time"
+)
+
+// How long to wait between health checks while waiting for Airbyte to start, doubling from the minimum
+var (
+	startupMinBackoff = 1 * time.Second
+	startupMaxBackoff = 15 * time.Second
+)
+
+// getStartupTimeout returns how long to wait for Airbyte to become available, 0 to check it only once
+func getStartupTimeout(data AirbyteProviderModel, diags *diag.Diagnostics) time.Duration {
+	timeout := int64ValueOrEnv(data.StartupTimeout, "AIRBYTE_STARTUP_TIMEOUT", 0, path.Root("startup_timeout"), diags)
+	if timeout < 0 {
+		diags.AddAttributeError(
+			path.Root("startup_timeout"),
+			"Invalid startup timeout",
+			fmt.Sprintf("startup_timeout must not be negative, got: %d", timeout),
+		)
+		return 0
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// getSkipHealthCheck returns whether the provider should be configured without contacting Airbyte
+func getSkipHealthCheck(data AirbyteProviderModel, diags *diag.Diagnostics) bool {
+	if !data.SkipHealthCheck.IsNull() {
+		return data.SkipHealthCheck.ValueBool()
+	}
+	if v, ok := os.LookupEnv("AIRBYTE_SKIP_HEALTH_CHECK"); ok && v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("skip_health_check"),
+				"Invalid AIRBYTE_SKIP_HEALTH_CHECK",
+				fmt.Sprintf("AIRBYTE_SKIP_HEALTH_CHECK must be a boolean, got: %s", v),
+			)
+			return false
+		}
+		return b
+	}
+	return false
+}
+
+// waitForServer checks the server until it is available or timeout expires, backing off between
+// attempts. It returns the error of the last check if the server never became available.
+func waitForServer(ctx context.Context, client apiclient.Client, timeout time.Duration) error {
+	if timeout == 0 {
+		return client.Check(ctx)
+	}
+
+	deadline := time.Now().Add(timeout)
+	backoff := startupMinBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		checkCtx, cancel := context.WithDeadline(ctx, deadline)
+		checkErr := client.Check(checkCtx)
+		cancel()
+		if checkErr == nil {
+			tflog.Info(ctx, "Airbyte is available", map[string]interface{}{"attempts": attempt})
+			return nil
+		}
+		// A check cut short by the deadline says less about why Airbyte isn't available than the previous one
+		if err == nil || checkCtx.Err() == nil {
+			err = checkErr
+		}
+
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return fmt.Errorf("Airbyte was not available after %s: %w", timeout, err)
+		}
+		if backoff < wait {
+			wait = backoff
+		}
+		tflog.Info(ctx, "Waiting for Airbyte to become available", map[string]interface{}{
+			"error":   err.Error(),
+			"wait_ms": wait.Milliseconds(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > startupMaxBackoff {
+			backoff = startupMaxBackoff
+		}
+	}
+}
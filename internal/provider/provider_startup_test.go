@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+)
+
+func TestWaitForServer(t *testing.T) {
+	minBackoff, maxBackoff := startupMinBackoff, startupMaxBackoff
+	startupMinBackoff, startupMaxBackoff = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() { startupMinBackoff, startupMaxBackoff = minBackoff, maxBackoff })
+
+	checks := 0
+	availableAfter := 3
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		checks++
+		fmt.Fprintf(w, `{"available": %t}`, checks >= availableAfter)
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.RetryMax = 0
+	httpClient.Logger = nil
+	client := &apiclient.ApiClient{HostURL: server.URL, HTTPClient: httpClient}
+
+	// Without a timeout the server is checked once
+	if err := waitForServer(context.Background(), client, 0); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if checks != 1 {
+		t.Fatalf("expected 1 check, got %d", checks)
+	}
+
+	checks = 0
+	if err := waitForServer(context.Background(), client, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if checks != availableAfter {
+		t.Fatalf("expected %d checks, got %d", availableAfter, checks)
+	}
+
+	checks = 0
+	availableAfter = 1000000
+	err := waitForServer(context.Background(), client, 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "not available after 50ms") || !strings.Contains(err.Error(), "available: false") {
+		t.Fatalf("expected the last check's error, got: %v", err)
+	}
+}
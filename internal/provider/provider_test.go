@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/airbytefake"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -20,3 +25,40 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 func testAccPreCheck(t *testing.T) {
 	//testAccProvider.Configure(context.Background(), provider.ConfigureRequest{}, &provider.ConfigureResponse{})
 }
+
+// TestMain runs the tests against an in-memory fake of the Airbyte API, unless AIRBYTE_URL points
+// them at a live Airbyte.
+func TestMain(m *testing.M) {
+	if _, ok := os.LookupEnv("AIRBYTE_URL"); ok {
+		os.Exit(m.Run())
+	}
+
+	fake := airbytefake.New()
+	fake.Catalogs["eabrouwer3/airbyte-test-data-source"] = testDataSourceCatalog
+	server := httptest.NewServer(fake)
+	os.Setenv("AIRBYTE_URL", server.URL)
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// testDataSourceCatalog is the catalog eabrouwer3/airbyte-test-data-source discovers
+var testDataSourceCatalog = apiclient.SyncCatalog{
+	Streams: []apiclient.Stream{{
+		Stream: apiclient.SourceStreamSchema{
+			Name:               "appliances",
+			JsonSchema:         json.RawMessage(`{"type":"object","$schema":"http://json-schema.org/draft-07/schema#","properties":{"id":{"type":"integer"},"uid":{"type":"string"},"brand":{"type":"string"},"equipment":{"type":"string"}}}`),
+			SupportedSyncModes: []string{"incremental", "full_refresh"},
+		},
+		Config: apiclient.DestinationStreamConfig{
+			SyncMode:            "full_refresh",
+			DestinationSyncMode: "overwrite",
+			Selected:            boolPtr(true),
+		},
+	}},
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
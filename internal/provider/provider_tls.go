@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// pemOrFile returns value if it holds PEM data, otherwise the contents of the file it points to.
+func pemOrFile(value string) ([]byte, error) {
+	if value == "" || strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// getTLSConfig returns the TLS configuration for the API client, or nil if the defaults should be used.
+func getTLSConfig(data AirbyteProviderModel, diags *diag.Diagnostics) *tls.Config {
+	caCertFile := configValueOrEnv(data.CACertFile, "AIRBYTE_CA_CERT_FILE", "")
+	caCertPem := configValueOrEnv(data.CACertPem, "AIRBYTE_CA_CERT_PEM", "")
+	clientCert := configValueOrEnv(data.ClientCert, "AIRBYTE_CLIENT_CERT", "")
+	clientKey := configValueOrEnv(data.ClientKey, "AIRBYTE_CLIENT_KEY", "")
+
+	insecureSkipVerify := false
+	if !data.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if v, ok := os.LookupEnv("AIRBYTE_INSECURE_SKIP_VERIFY"); ok && v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid AIRBYTE_INSECURE_SKIP_VERIFY",
+				fmt.Sprintf("AIRBYTE_INSECURE_SKIP_VERIFY must be a boolean, got: %s", v),
+			)
+			return nil
+		}
+		insecureSkipVerify = b
+	}
+
+	if caCertFile == "" && caCertPem == "" && clientCert == "" && clientKey == "" && !insecureSkipVerify {
+		return nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertFile != "" || caCertPem != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if caCertFile != "" {
+			pem, err := os.ReadFile(caCertFile)
+			if err != nil {
+				diags.AddAttributeError(path.Root("ca_cert_file"), "Unable to read CA bundle", err.Error())
+			} else if !pool.AppendCertsFromPEM(pem) {
+				diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA bundle", "No PEM encoded certificates found in "+caCertFile)
+			}
+		}
+		if caCertPem != "" && !pool.AppendCertsFromPEM([]byte(caCertPem)) {
+			diags.AddAttributeError(path.Root("ca_cert_pem"), "Invalid CA bundle", "No PEM encoded certificates found in ca_cert_pem")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Incomplete client certificate",
+				"Both client_cert and client_key (or AIRBYTE_CLIENT_CERT and AIRBYTE_CLIENT_KEY) must be set for mutual TLS.",
+			)
+			return nil
+		}
+		certPem, err := pemOrFile(clientCert)
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert"), "Unable to read client certificate", err.Error())
+			return nil
+		}
+		keyPem, err := pemOrFile(clientKey)
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_key"), "Unable to read client key", err.Error())
+			return nil
+		}
+		cert, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert"), "Invalid client certificate", err.Error())
+			return nil
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"encoding/pem"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	var diags diag.Diagnostics
+	if tlsConfig := getTLSConfig(AirbyteProviderModel{}, &diags); tlsConfig != nil || diags.HasError() {
+		t.Fatalf("expected no TLS config without TLS settings, got %v, %v", tlsConfig, diags)
+	}
+
+	tlsConfig := getTLSConfig(AirbyteProviderModel{CACertPem: types.StringValue(string(caPem))}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	res, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the custom CA to be trusted: %s", err)
+	}
+	res.Body.Close()
+
+	getTLSConfig(AirbyteProviderModel{ClientCert: types.StringValue(string(caPem))}, &diags)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a client certificate without a key")
+	}
+}
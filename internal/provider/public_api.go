@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+)
+
+// warnIgnoredAttribute warns that the attribute at p is set but can't be applied through the public API
+func warnIgnoredAttribute(diags *diag.Diagnostics, p path.Path) {
+	diags.AddAttributeWarning(
+		p,
+		"Attribute Ignored by the Airbyte Public API",
+		"This attribute can't be managed through Airbyte's public API, so its value is kept in the state without being applied. "+
+			"Set api_flavor to config in the provider configuration to manage it.",
+	)
+}
+
+// warnIgnoredAttributes warns about each of the top-level attributes in values that is set
+func warnIgnoredAttributes(diags *diag.Diagnostics, values map[string]attr.Value) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if v := values[name]; !v.IsNull() && !v.IsUnknown() {
+			warnIgnoredAttribute(diags, path.Root(name))
+		}
+	}
+}
+
+// knownBool returns v, or null if it is unknown, as values kept from a plan must be known in the state
+func knownBool(v types.Bool) types.Bool {
+	if v.IsUnknown() {
+		return types.BoolNull()
+	}
+	return v
+}
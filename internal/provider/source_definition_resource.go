@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +16,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &SourceDefinitionResource{}
 var _ resource.ResourceWithImportState = &SourceDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &SourceDefinitionResource{}
 
 func NewSourceDefinitionResource() resource.Resource {
 	return &SourceDefinitionResource{}
@@ -22,7 +24,8 @@ func NewSourceDefinitionResource() resource.Resource {
 
 // SourceDefinitionResource defines the resource implementation.
 type SourceDefinitionResource struct {
-	client *apiclient.ApiClient
+	client             apiclient.ConnectorDefinitionsAPI
+	defaultWorkspaceId string
 }
 
 func (r *SourceDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,9 +47,10 @@ func (r *SourceDefinitionResource) GetSchema(ctx context.Context) (tfsdk.Schema,
 				},
 			},
 			"workspace_id": {
-				Description: "Workspace ID",
+				Description: "Workspace ID (Default: the default_workspace_id or default_workspace_slug of the provider)",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": {
 				Description: "Source Definition Name",
@@ -166,18 +170,23 @@ func (r *SourceDefinitionResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.defaultWorkspaceId = data.DefaultWorkspaceId
+}
+
+func (r *SourceDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultWorkspaceId(ctx, req, resp, r.defaultWorkspaceId, false)
 }
 
 func (r *SourceDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -197,11 +206,14 @@ func (r *SourceDefinitionResource) Create(ctx context.Context, req resource.Crea
 		SourceDefinition: &commonFields,
 	}
 
-	sourceDefinition, err := r.client.CreateConnectorDefinition(newSourceDefinition, apiclient.SourceType)
+	sourceDefinition, err := r.client.CreateConnectorDefinition(ctx, newSourceDefinition, apiclient.SourceType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorDefinitionPropertyPaths,
 			"Error creating Source Definition",
-			"Could not create Source Definition, unexpected error: "+err.Error(),
+			"Could not create Source Definition, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -230,8 +242,13 @@ func (r *SourceDefinitionResource) Read(ctx context.Context, req resource.ReadRe
 
 	sourceDefinitionId := plan.Id.ValueString()
 
-	sourceDefinition, err := r.client.GetConnectorDefinitionById(sourceDefinitionId, apiclient.SourceType)
+	sourceDefinition, err := r.client.GetConnectorDefinitionById(ctx, sourceDefinitionId, apiclient.SourceType)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Source Definition, got error: %s", err))
 		return
 	}
@@ -263,11 +280,14 @@ func (r *SourceDefinitionResource) Update(ctx context.Context, req resource.Upda
 		ResourceRequirements: getResourceRequirementFields(plan),
 	}
 
-	sourceDefinition, err := r.client.UpdateConnectorDefinition(updatedSourceDefinition, apiclient.SourceType)
+	sourceDefinition, err := r.client.UpdateConnectorDefinition(ctx, updatedSourceDefinition, apiclient.SourceType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorDefinitionPropertyPaths,
 			"Error updating Source Definition",
-			"Could not update Source Definition, unexpected error: "+err.Error(),
+			"Could not update Source Definition, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -288,7 +308,7 @@ func (r *SourceDefinitionResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	sourceDefinitionId := state.Id.ValueString()
-	err := r.client.DeleteConnectorDefinition(sourceDefinitionId, apiclient.SourceType)
+	err := r.client.DeleteConnectorDefinition(ctx, sourceDefinitionId, apiclient.SourceType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Source Definition",
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,6 +15,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &SourceResource{}
 var _ resource.ResourceWithImportState = &SourceResource{}
+var _ resource.ResourceWithModifyPlan = &SourceResource{}
 
 func NewSourceResource() resource.Resource {
 	return &SourceResource{}
@@ -21,7 +23,8 @@ func NewSourceResource() resource.Resource {
 
 // SourceResource defines the resource implementation.
 type SourceResource struct {
-	client *apiclient.ApiClient
+	client             apiclient.ConnectorsAPI
+	defaultWorkspaceId string
 }
 
 func (r *SourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,9 +54,10 @@ func (r *SourceResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				},
 			},
 			"workspace_id": {
-				Description: "Workspace ID",
+				Description: "Workspace ID (Default: the default_workspace_id or default_workspace_slug of the provider)",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					resource.RequiresReplace(),
 				},
@@ -79,6 +83,22 @@ func (r *SourceResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				Type:        types.StringType,
 				Computed:    true,
 			},
+			"check_connection": checkConnectionAttribute("source"),
+			"last_check_status": {
+				Description: "Status of the last check of the Source configuration, 'succeeded' or 'failed'. Null if check_connection is 'skip'",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"last_check_message": {
+				Description: "Message of the last check of the Source configuration",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"last_check_job_id": {
+				Description: "Job ID of the last check of the Source configuration",
+				Type:        types.StringType,
+				Computed:    true,
+			},
 		},
 	}, nil
 }
@@ -89,18 +109,23 @@ func (r *SourceResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.defaultWorkspaceId = data.DefaultWorkspaceId
+}
+
+func (r *SourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultWorkspaceId(ctx, req, resp, r.defaultWorkspaceId, true)
 }
 
 func (r *SourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -122,11 +147,22 @@ func (r *SourceResource) Create(ctx context.Context, req resource.CreateRequest,
 		CommonConnectorFields: GetCommonConnectorFields(plan),
 	}
 
-	source, err := r.client.CreateConnector(newSource, apiclient.SourceType)
+	checkNew := func() (*apiclient.CheckConnectionResponse, error) {
+		return r.client.CheckNewConnector(ctx, newSource, apiclient.SourceType)
+	}
+	checkResponse, ok := checkConnector(plan, "source", checkNew, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	source, err := r.client.CreateConnector(ctx, newSource, apiclient.SourceType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorPropertyPaths,
 			"Error creating Source",
-			"Could not create Source, unexpected error: "+err.Error(),
+			"Could not create Source, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -140,6 +176,8 @@ func (r *SourceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	state.ConnectionConfiguration = plan.ConnectionConfiguration
+	state.CheckConnection = plan.CheckConnection
+	state.setLastCheck(checkResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -155,8 +193,13 @@ func (r *SourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	sourceId := plan.Id.ValueString()
 
-	source, err := r.client.GetConnectorById(sourceId, apiclient.SourceType)
+	source, err := r.client.GetConnectorById(ctx, sourceId, apiclient.SourceType)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Source, got error: %s", err))
 		return
 	}
@@ -169,7 +212,18 @@ func (r *SourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 		)
 		return
 	}
-	state.ConnectionConfiguration = plan.ConnectionConfiguration
+	state.ConnectionConfiguration, err = readConnectionConfiguration(plan.ConnectionConfiguration, source.ConnectionConfiguration)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Source, got error: %s", err),
+		)
+		return
+	}
+	state.CheckConnection = plan.CheckConnection
+	state.LastCheckStatus = plan.LastCheckStatus
+	state.LastCheckMessage = plan.LastCheckMessage
+	state.LastCheckJobId = plan.LastCheckJobId
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -188,11 +242,22 @@ func (r *SourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		CommonConnectorFields: GetCommonConnectorFields(plan),
 	}
 
-	source, err := r.client.UpdateConnector(updatedSource, apiclient.SourceType)
+	checkUpdated := func() (*apiclient.CheckConnectionResponse, error) {
+		return r.client.CheckUpdatedConnector(ctx, updatedSource, apiclient.SourceType)
+	}
+	checkResponse, ok := checkConnector(plan, "source", checkUpdated, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	source, err := r.client.UpdateConnector(ctx, updatedSource, apiclient.SourceType)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			connectorPropertyPaths,
 			"Error updating source",
-			"Could not update Source, unexpected error: "+err.Error(),
+			"Could not update Source, unexpected error: ",
+			err,
 		)
 		return
 	}
@@ -206,6 +271,8 @@ func (r *SourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	state.ConnectionConfiguration = plan.ConnectionConfiguration
+	state.CheckConnection = plan.CheckConnection
+	state.setLastCheck(checkResponse)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -220,7 +287,7 @@ func (r *SourceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	sourceId := state.Id.ValueString()
-	err := r.client.DeleteConnector(sourceId, apiclient.SourceType)
+	err := r.client.DeleteConnector(ctx, sourceId, apiclient.SourceType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating source",
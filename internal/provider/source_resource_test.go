@@ -1,12 +1,128 @@
 package provider
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
 
+// fakeConnectors serves GetConnectorById from connectors. Any other call panics.
+type fakeConnectors struct {
+	apiclient.ConnectorsAPI
+	connectors map[string]*apiclient.Connector
+}
+
+func (f fakeConnectors) GetConnectorById(ctx context.Context, connectorId string, t apiclient.ConnectorType) (*apiclient.Connector, error) {
+	if connector, ok := f.connectors[connectorId]; ok {
+		return connector, nil
+	}
+	return nil, &apiclient.NotFoundError{}
+}
+
+func TestSourceResourceRead(t *testing.T) {
+	ctx := context.Background()
+	r := &SourceResource{client: fakeConnectors{connectors: map[string]*apiclient.Connector{
+		"s1": {
+			SourceIdBody:           apiclient.SourceIdBody{SourceId: "s1"},
+			SourceDefinitionIdBody: apiclient.SourceDefinitionIdBody{SourceDefinitionId: "d1"},
+			WorkspaceIdBody:        apiclient.WorkspaceIdBody{WorkspaceId: "w1"},
+			CommonConnectorFields:  apiclient.CommonConnectorFields{Name: "renamed"},
+			SourceName:             "Faker",
+		},
+	}}}
+	schema, diags := r.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	read := func(id string) *fwresource.ReadResponse {
+		state := tfsdk.State{Schema: schema}
+		diags := state.Set(ctx, ConnectorModel{
+			Id:                      types.StringValue(id),
+			DefinitionId:            types.StringValue("d1"),
+			WorkspaceId:             types.StringValue("w1"),
+			Name:                    types.StringValue("test"),
+			ConnectionConfiguration: types.StringValue(`{}`),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		return resp
+	}
+
+	var data ConnectorModel
+	resp := read("s1")
+	resp.State.Get(ctx, &data)
+	if data.Name.ValueString() != "renamed" || data.DefinitionName.ValueString() != "Faker" {
+		t.Fatalf("expected state to be refreshed from Airbyte, got %+v", data)
+	}
+
+	resp = read("s2")
+	if !resp.State.Raw.IsNull() {
+		t.Fatalf("expected a source missing from Airbyte to be removed from state")
+	}
+}
+
+func TestSourceResourceModifyPlanDefaultWorkspace(t *testing.T) {
+	ctx := context.Background()
+	r := &SourceResource{defaultWorkspaceId: "w2"}
+	schema, diags := r.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	modifyPlan := func(configured types.String, prior types.String) *fwresource.ModifyPlanResponse {
+		config := tfsdk.State{Schema: schema}
+		config.Set(ctx, ConnectorModel{WorkspaceId: configured, Name: types.StringValue("test")})
+		plan := tfsdk.Plan{Schema: schema, Raw: config.Raw}
+		state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}
+		if !prior.IsNull() {
+			state.Set(ctx, ConnectorModel{Id: types.StringValue("s1"), WorkspaceId: prior, Name: types.StringValue("test")})
+		}
+
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schema, Raw: config.Raw},
+			Plan:   plan,
+			State:  state,
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		return resp
+	}
+
+	var data ConnectorModel
+	resp := modifyPlan(types.StringNull(), types.StringNull())
+	resp.Plan.Get(ctx, &data)
+	if data.WorkspaceId.ValueString() != "w2" {
+		t.Fatalf("expected the default workspace to be planned, got %s", data.WorkspaceId)
+	}
+
+	// A new default replaces sources inheriting the previous one, but not those setting workspace_id
+	resp = modifyPlan(types.StringNull(), types.StringValue("w1"))
+	if len(resp.RequiresReplace) != 1 {
+		t.Fatalf("expected a replacement, got %v", resp.RequiresReplace)
+	}
+	resp = modifyPlan(types.StringValue("w1"), types.StringValue("w1"))
+	resp.Plan.Get(ctx, &data)
+	if data.WorkspaceId.ValueString() != "w1" || len(resp.RequiresReplace) != 0 {
+		t.Fatalf("expected the configured workspace to be kept, got %s and %v", data.WorkspaceId, resp.RequiresReplace)
+	}
+}
+
 func TestAccResourceSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
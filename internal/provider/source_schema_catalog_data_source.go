@@ -24,7 +24,7 @@ func NewSourceSchemaCatalogDataSource() datasource.DataSource {
 
 // SourceSchemaCatalogDataSource defines the data source implementation.
 type SourceSchemaCatalogDataSource struct {
-	client *apiclient.ApiClient
+	client apiclient.SourceSchemaCatalogsAPI
 }
 
 func (d *SourceSchemaCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -152,18 +152,18 @@ func (d *SourceSchemaCatalogDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = &client
+	d.client = data.Client
 }
 
 func (d *SourceSchemaCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -178,7 +178,7 @@ func (d *SourceSchemaCatalogDataSource) Read(ctx context.Context, req datasource
 
 	sourceId := config.SourceId.ValueString()
 
-	sourceSchemaCatalog, err := d.client.GetSourceSchemaCatalogById(sourceId)
+	sourceSchemaCatalog, err := d.client.GetSourceSchemaCatalogById(ctx, sourceId)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Source Schema Catalog, got error: %s", err))
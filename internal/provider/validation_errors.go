@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// propertyPathMapping translates the property paths Airbyte reports in validation errors
+// (e.g. syncCatalog.streams[3].config.cursorField) into Terraform attribute paths.
+type propertyPathMapping struct {
+	// attributes maps API paths, without list indexes, to the attribute the API field is stored in.
+	// An empty attribute name means the API field only groups other fields and has no attribute of its own.
+	attributes map[string]string
+	// listIndexes translates the indexes of API lists that don't line up with the configured list.
+	listIndexes map[string][]int
+}
+
+var propertyPathSegmentRegex = regexp.MustCompile(`^([^\[\]]+)((?:\[\d+\])*)$`)
+var propertyPathIndexRegex = regexp.MustCompile(`\[(\d+)\]`)
+
+// withListIndexes returns a copy of m in which index i of the API list at apiPath refers to
+// element indexes[i] of the configured list.
+func (m propertyPathMapping) withListIndexes(apiPath string, indexes []int) propertyPathMapping {
+	listIndexes := map[string][]int{apiPath: indexes}
+	for k, v := range m.listIndexes {
+		if k != apiPath {
+			listIndexes[k] = v
+		}
+	}
+	return propertyPathMapping{attributes: m.attributes, listIndexes: listIndexes}
+}
+
+// attributePath returns the attribute path for propertyPath, and false if it does not map onto the schema.
+// Leading segments that aren't part of the request body (e.g. method argument names) are skipped.
+func (m propertyPathMapping) attributePath(propertyPath string) (path.Path, bool) {
+	segments := strings.Split(propertyPath, ".")
+	start := -1
+	for i, segment := range segments {
+		match := propertyPathSegmentRegex.FindStringSubmatch(segment)
+		if match == nil {
+			return path.Empty(), false
+		}
+		if _, ok := m.attributes[match[1]]; ok && start < 0 {
+			start = i
+		}
+	}
+	if start < 0 {
+		return path.Empty(), false
+	}
+
+	p := path.Empty()
+	found := false
+	apiPath := ""
+	for _, segment := range segments[start:] {
+		match := propertyPathSegmentRegex.FindStringSubmatch(segment)
+		if apiPath != "" {
+			apiPath += "."
+		}
+		apiPath += match[1]
+
+		name, ok := m.attributes[apiPath]
+		if !ok {
+			// Anything deeper (e.g. inside a JSON encoded attribute) can't be addressed
+			break
+		}
+		if name != "" {
+			if found {
+				p = p.AtName(name)
+			} else {
+				p = path.Root(name)
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+		for _, idx := range propertyPathIndexRegex.FindAllStringSubmatch(match[2], -1) {
+			i, _ := strconv.Atoi(idx[1])
+			if indexes, ok := m.listIndexes[apiPath]; ok && i < len(indexes) {
+				i = indexes[i]
+			}
+			p = p.AtListIndex(i)
+		}
+	}
+
+	return p, found
+}
+
+// addClientErrorDiagnostics adds err to diags. Each Airbyte validation error whose property path maps onto
+// the schema is reported against that attribute, anything else falls back to a single error.
+func addClientErrorDiagnostics(diags *diag.Diagnostics, paths propertyPathMapping, summary string, detail string, err error) {
+	var validationErr *apiclient.ValidationError
+	if errors.As(err, &validationErr) && len(validationErr.ValidationErrors) > 0 {
+		unmapped := false
+		for _, v := range validationErr.ValidationErrors {
+			p, ok := paths.attributePath(v.PropertyPath)
+			if !ok {
+				unmapped = true
+				continue
+			}
+			msg := v.Message
+			if v.InvalidValue != "" {
+				msg = fmt.Sprintf("%s (invalid value: %s)", msg, v.InvalidValue)
+			}
+			diags.AddAttributeError(p, summary, msg)
+		}
+		if !unmapped {
+			return
+		}
+	}
+
+	diags.AddError(summary, detail+err.Error())
+}
+
+var connectorPropertyPaths = propertyPathMapping{
+	attributes: map[string]string{
+		"name":                    "name",
+		"workspaceId":             "workspace_id",
+		"sourceDefinitionId":      "definition_id",
+		"destinationDefinitionId": "definition_id",
+		"connectionConfiguration": "connection_configuration",
+	},
+}
+
+var connectionPropertyPaths = propertyPathMapping{
+	attributes: map[string]string{
+		"sourceId":                              "source_id",
+		"destinationId":                         "destination_id",
+		"status":                                "status",
+		"name":                                  "name",
+		"namespaceDefinition":                   "namespace_definition",
+		"namespaceFormat":                       "namespace_format",
+		"prefix":                                "prefix",
+		"operationIds":                          "operation_ids",
+		"syncCatalog":                           "",
+		"syncCatalog.streams":                   "sync_catalog",
+		"syncCatalog.streams.stream":            "source_schema",
+		"syncCatalog.streams.stream.name":       "name",
+		"syncCatalog.streams.stream.jsonSchema": "json_schema",
+		"syncCatalog.streams.stream.supportedSyncModes":      "supported_sync_modes",
+		"syncCatalog.streams.stream.sourceDefinedCursor":     "source_defined_cursor",
+		"syncCatalog.streams.stream.defaultCursorField":      "default_cursor_field",
+		"syncCatalog.streams.stream.sourceDefinedPrimaryKey": "source_defined_primary_key",
+		"syncCatalog.streams.stream.namespace":               "namespace",
+		"syncCatalog.streams.config":                         "destination_config",
+		"syncCatalog.streams.config.syncMode":                "sync_mode",
+		"syncCatalog.streams.config.cursorField":             "cursor_field",
+		"syncCatalog.streams.config.destinationSyncMode":     "destination_sync_mode",
+		"syncCatalog.streams.config.primaryKey":              "primary_key",
+		"syncCatalog.streams.config.aliasName":               "alias_name",
+		"syncCatalog.streams.config.selected":                "selected",
+		"scheduleType":                                       "schedule_type",
+		"scheduleData":                                       "",
+		"scheduleData.basicSchedule":                         "basic_schedule",
+		"scheduleData.basicSchedule.units":                   "units",
+		"scheduleData.basicSchedule.timeUnit":                "time_unit",
+		"scheduleData.cron":                                  "cron_schedule",
+		"scheduleData.cron.cronExpression":                   "cron_expression",
+		"scheduleData.cron.cronTimeZone":                     "cron_time_zone",
+		"resourceRequirements":                               "resource_requirements",
+		"resourceRequirements.cpu_request":                   "cpu_request",
+		"resourceRequirements.cpu_limit":                     "cpu_limit",
+		"resourceRequirements.memory_request":                "memory_request",
+		"resourceRequirements.memory_limit":                  "memory_limit",
+		"sourceCatalogId":                                    "source_catalog_id",
+	},
+}
+
+var workspacePropertyPaths = propertyPathMapping{
+	attributes: map[string]string{
+		"name":                             "name",
+		"email":                            "email",
+		"anonymousDataCollection":          "anonymous_data_collection",
+		"news":                             "news",
+		"securityUpdates":                  "security_updates",
+		"displaySetupWizard":               "display_setup_wizard",
+		"notifications":                    "notification_config",
+		"notifications.notificationType":   "notification_type",
+		"notifications.sendOnSuccess":      "send_on_success",
+		"notifications.sendOnFailure":      "send_on_failure",
+		"notifications.slackConfiguration": "",
+		"notifications.slackConfiguration.webhook": "slack_webhook",
+	},
+}
+
+var operationPropertyPaths = propertyPathMapping{
+	attributes: map[string]string{
+		"workspaceId":                                   "workspace_id",
+		"name":                                          "name",
+		"operatorConfiguration":                         "",
+		"operatorConfiguration.operatorType":            "operator_type",
+		"operatorConfiguration.normalization":           "",
+		"operatorConfiguration.normalization.option":    "normalization_option",
+		"operatorConfiguration.dbt":                     "dbt",
+		"operatorConfiguration.dbt.gitRepoUrl":          "git_repo_url",
+		"operatorConfiguration.dbt.gitRepoBranch":       "git_repo_branch",
+		"operatorConfiguration.dbt.dockerImage":         "docker_image",
+		"operatorConfiguration.dbt.dbtArguments":        "dbt_arguments",
+		"operatorConfiguration.webhook":                 "webhook",
+		"operatorConfiguration.webhook.executionUrl":    "execution_url",
+		"operatorConfiguration.webhook.executionBody":   "execution_body",
+		"operatorConfiguration.webhook.webhookConfigId": "webhook_config_id",
+	},
+}
+
+var connectorDefinitionPropertyPaths = func() propertyPathMapping {
+	fields := map[string]string{
+		"name":                                     "name",
+		"dockerRepository":                         "docker_repository",
+		"dockerImageTag":                           "docker_image_tag",
+		"documentationUrl":                         "documentation_url",
+		"resourceRequirements":                     "",
+		"resourceRequirements.default":             "default_resource_requirements",
+		"resourceRequirements.jobSpecific":         "job_specific_resource_requirements",
+		"resourceRequirements.jobSpecific.jobType": "job_type",
+		"resourceRequirements.jobSpecific.resourceRequirements": "",
+	}
+	for _, f := range []string{"cpu_request", "cpu_limit", "memory_request", "memory_limit"} {
+		fields["resourceRequirements.default."+f] = f
+		fields["resourceRequirements.jobSpecific.resourceRequirements."+f] = f
+	}
+
+	// Updates send the fields at the top level, creates nest them under the definition
+	attributes := map[string]string{
+		"workspaceId":           "workspace_id",
+		"sourceDefinition":      "",
+		"destinationDefinition": "",
+	}
+	for k, v := range fields {
+		attributes[k] = v
+		attributes["sourceDefinition."+k] = v
+		attributes["destinationDefinition."+k] = v
+	}
+
+	return propertyPathMapping{attributes: attributes}
+}()
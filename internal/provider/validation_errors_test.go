@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
+	"testing"
+)
+
+func TestPropertyPathMappingAttributePath(t *testing.T) {
+	testCases := map[string]struct {
+		mapping      propertyPathMapping
+		propertyPath string
+		expected     path.Path
+		ok           bool
+	}{
+		"connection-stream-config": {
+			mapping:      connectionPropertyPaths,
+			propertyPath: "syncCatalog.streams[3].config.cursorField",
+			expected:     path.Root("sync_catalog").AtListIndex(3).AtName("destination_config").AtName("cursor_field"),
+			ok:           true,
+		},
+		"connection-nested-list": {
+			mapping:      connectionPropertyPaths,
+			propertyPath: "syncCatalog.streams[0].config.primaryKey[1][0]",
+			expected:     path.Root("sync_catalog").AtListIndex(0).AtName("destination_config").AtName("primary_key").AtListIndex(1).AtListIndex(0),
+			ok:           true,
+		},
+		"connection-unselected-streams": {
+			mapping:      connectionPropertyPaths.withListIndexes("syncCatalog.streams", []int{1, 4}),
+			propertyPath: "syncCatalog.streams[1].stream.name",
+			expected:     path.Root("sync_catalog").AtListIndex(4).AtName("source_schema").AtName("name"),
+			ok:           true,
+		},
+		"connection-argument-prefix": {
+			mapping:      connectionPropertyPaths,
+			propertyPath: "createConnection.connectionCreate.scheduleData.cron.cronExpression",
+			expected:     path.Root("cron_schedule").AtName("cron_expression"),
+			ok:           true,
+		},
+		"connector-json-configuration": {
+			mapping:      connectorPropertyPaths,
+			propertyPath: "connectionConfiguration.host",
+			expected:     path.Root("connection_configuration"),
+			ok:           true,
+		},
+		"workspace-notification": {
+			mapping:      workspacePropertyPaths,
+			propertyPath: "notifications[2].slackConfiguration.webhook",
+			expected:     path.Root("notification_config").AtListIndex(2).AtName("slack_webhook"),
+			ok:           true,
+		},
+		"operation-normalization": {
+			mapping:      operationPropertyPaths,
+			propertyPath: "operatorConfiguration.normalization.option",
+			expected:     path.Root("normalization_option"),
+			ok:           true,
+		},
+		"definition-create": {
+			mapping:      connectorDefinitionPropertyPaths,
+			propertyPath: "sourceDefinition.resourceRequirements.jobSpecific[1].resourceRequirements.cpu_limit",
+			expected:     path.Root("job_specific_resource_requirements").AtListIndex(1).AtName("cpu_limit"),
+			ok:           true,
+		},
+		"definition-update": {
+			mapping:      connectorDefinitionPropertyPaths,
+			propertyPath: "dockerImageTag",
+			expected:     path.Root("docker_image_tag"),
+			ok:           true,
+		},
+		"unknown": {
+			mapping:      workspacePropertyPaths,
+			propertyPath: "workspaceId",
+			expected:     path.Empty(),
+			ok:           false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			got, ok := testCase.mapping.attributePath(testCase.propertyPath)
+			if ok != testCase.ok {
+				t.Fatalf("expected ok %t, got %t", testCase.ok, ok)
+			}
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAddClientErrorDiagnostics(t *testing.T) {
+	err := &apiclient.ValidationError{
+		Response422: apiclient.Response422{
+			ValidationErrors: []apiclient.PropertyValidationError{
+				{PropertyPath: "name", InvalidValue: "", Message: "must not be blank"},
+				{PropertyPath: "scheduleType", InvalidValue: "weekly", Message: "invalid schedule type"},
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addClientErrorDiagnostics(&diags, connectionPropertyPaths, "Error creating connection", "Could not create connection: ", err)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	for i, expected := range []path.Path{path.Root("name"), path.Root("schedule_type")} {
+		withPath, ok := diags[i].(diag.DiagnosticWithPath)
+		if !ok {
+			t.Fatalf("expected diagnostic %d to have a path", i)
+		}
+		if !withPath.Path().Equal(expected) {
+			t.Errorf("expected diagnostic %d at %s, got %s", i, expected, withPath.Path())
+		}
+	}
+
+	diags = nil
+	err.ValidationErrors = append(err.ValidationErrors, apiclient.PropertyValidationError{PropertyPath: "unknownField"})
+	addClientErrorDiagnostics(&diags, connectionPropertyPaths, "Error creating connection", "Could not create connection: ", err)
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %d: %v", len(diags), diags)
+	}
+	if _, ok := diags[2].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected the unmapped error to be reported without a path")
+	}
+}
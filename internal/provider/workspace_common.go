@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
@@ -42,29 +45,67 @@ func FlattenWorkspace(workspace *apiclient.Workspace) WorkspaceModel {
 		for _, notifConfig := range workspace.Notifications {
 			data.NotificationConfig = append(data.NotificationConfig, workspaceNotificationConfigModel{
 				NotificationType: types.StringValue(notifConfig.NotificationType),
-				SendOnSuccess:    types.BoolValue(*notifConfig.SendOnSuccess),
-				SendOnFailure:    types.BoolValue(*notifConfig.SendOnFailure),
+				SendOnSuccess:    boolValueOrNull(notifConfig.SendOnSuccess),
+				SendOnFailure:    boolValueOrNull(notifConfig.SendOnFailure),
 				SlackWebhook:     types.StringValue(notifConfig.SlackConfiguration.Webhook),
 			})
 		}
 	}
 	data.Email = types.StringValue(workspace.Email)
-	data.InitialSetupComplete = types.BoolValue(*workspace.InitialSetupComplete)
-	data.DisplaySetupWizard = types.BoolValue(*workspace.DisplaySetupWizard)
-	data.AnonymousDataCollection = types.BoolValue(*workspace.AnonymousDataCollection)
-	data.News = types.BoolValue(*workspace.News)
-	data.SecurityUpdates = types.BoolValue(*workspace.SecurityUpdates)
-	if v := workspace.FirstCompletedSync; v != nil {
-		data.FirstCompletedSync = types.BoolValue(*v)
-	} else {
-		data.FirstCompletedSync = types.BoolNull()
-	}
-	if v := workspace.FeedbackDone; v != nil {
-		data.FeedbackDone = types.BoolValue(*v)
-	} else {
-		data.FeedbackDone = types.BoolNull()
-	}
+	data.InitialSetupComplete = boolValueOrNull(workspace.InitialSetupComplete)
+	data.DisplaySetupWizard = boolValueOrNull(workspace.DisplaySetupWizard)
+	data.AnonymousDataCollection = boolValueOrNull(workspace.AnonymousDataCollection)
+	data.News = boolValueOrNull(workspace.News)
+	data.SecurityUpdates = boolValueOrNull(workspace.SecurityUpdates)
+	data.FirstCompletedSync = boolValueOrNull(workspace.FirstCompletedSync)
+	data.FeedbackDone = boolValueOrNull(workspace.FeedbackDone)
 	data.DefaultGeography = types.StringValue(workspace.DefaultGeography)
 
 	return data
 }
+
+func boolValueOrNull(v *bool) types.Bool {
+	if v == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*v)
+}
+
+// keepUnreportedWorkspaceFields copies the settings the public API doesn't return over from prior, the
+// plan or prior state, and warns about the ones that are set in plan.
+func keepUnreportedWorkspaceFields(state *WorkspaceModel, prior WorkspaceModel, diags *diag.Diagnostics) {
+	if diags != nil {
+		warnIgnoredAttributes(diags, map[string]attr.Value{
+			"email":                     prior.Email,
+			"anonymous_data_collection": prior.AnonymousDataCollection,
+			"news":                      prior.News,
+			"security_updates":          prior.SecurityUpdates,
+			"display_setup_wizard":      prior.DisplaySetupWizard,
+		})
+		if len(prior.NotificationConfig) > 0 {
+			warnIgnoredAttribute(diags, path.Root("notification_config"))
+		}
+	}
+
+	if !prior.Email.IsUnknown() {
+		state.Email = prior.Email
+	}
+	state.AnonymousDataCollection = knownBool(prior.AnonymousDataCollection)
+	state.News = knownBool(prior.News)
+	state.SecurityUpdates = knownBool(prior.SecurityUpdates)
+	state.DisplaySetupWizard = knownBool(prior.DisplaySetupWizard)
+	state.NotificationConfig = nil
+	for _, notification := range prior.NotificationConfig {
+		notification.SendOnSuccess = knownBool(notification.SendOnSuccess)
+		notification.SendOnFailure = knownBool(notification.SendOnFailure)
+		state.NotificationConfig = append(state.NotificationConfig, notification)
+	}
+}
+
+// omitUnsupportedWorkspaceFields nulls the attributes the server's version doesn't have, which it
+// would otherwise report as empty values. The resource rejects them at plan time when configured.
+func omitUnsupportedWorkspaceFields(state *WorkspaceModel, capabilities apiclient.Capabilities) {
+	if !capabilities.Supports(apiclient.CapabilityGeography) {
+		state.DefaultGeography = types.StringNull()
+	}
+}
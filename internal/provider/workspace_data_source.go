@@ -24,7 +24,8 @@ func NewWorkspaceDataSource() datasource.DataSource {
 
 // WorkspaceDataSource defines the data source implementation.
 type WorkspaceDataSource struct {
-	client *apiclient.ApiClient
+	client       apiclient.WorkspacesAPI
+	capabilities apiclient.Capabilities
 }
 
 func (d *WorkspaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -150,18 +151,19 @@ func (d *WorkspaceDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = &client
+	d.client = data.Client
+	d.capabilities = data.Client.Capabilities()
 }
 
 func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -188,9 +190,9 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	var workspace *apiclient.Workspace
 	var err error
 	if workspaceId != "" {
-		workspace, err = d.client.GetWorkspaceById(workspaceId)
+		workspace, err = d.client.GetWorkspaceById(ctx, workspaceId)
 	} else if slug != "" {
-		workspace, err = d.client.GetWorkspaceBySlug(slug)
+		workspace, err = d.client.GetWorkspaceBySlug(ctx, slug)
 	}
 
 	if err != nil {
@@ -199,6 +201,7 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	state := FlattenWorkspace(workspace)
+	omitUnsupportedWorkspaceFields(&state, d.capabilities)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -26,7 +26,7 @@ func NewWorkspaceIdsDataSource() datasource.DataSource {
 
 // WorkspaceIdsDataSource defines the data source implementation.
 type WorkspaceIdsDataSource struct {
-	client *apiclient.ApiClient
+	client apiclient.WorkspacesAPI
 }
 
 type WorkspaceIdsModel struct {
@@ -63,18 +63,18 @@ func (d *WorkspaceIdsDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = &client
+	d.client = data.Client
 }
 
 func (d *WorkspaceIdsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -87,7 +87,7 @@ func (d *WorkspaceIdsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	wl, err := d.client.GetWorkspaces()
+	wl, err := d.client.GetWorkspaces(ctx)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
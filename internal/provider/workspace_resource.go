@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +16,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &WorkspaceResource{}
 var _ resource.ResourceWithImportState = &WorkspaceResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceResource{}
 
 func NewWorkspaceResource() resource.Resource {
 	return &WorkspaceResource{}
@@ -22,7 +24,12 @@ func NewWorkspaceResource() resource.Resource {
 
 // WorkspaceResource defines the resource implementation.
 type WorkspaceResource struct {
-	client *apiclient.ApiClient
+	client       apiclient.WorkspacesAPI
+	capabilities apiclient.Capabilities
+	// omitsAttributes is set when the client doesn't return every attribute of workspaces, see
+	// apiclient.Client.ReportsAllAttributes. The planned or prior values of those are kept, so they
+	// don't show up as drift.
+	omitsAttributes bool
 }
 
 func (r *WorkspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -146,7 +153,11 @@ func (r *WorkspaceResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 			"default_geography": {
 				Description: "Possible values: auto | us | eu",
 				Type:        types.StringType,
+				Optional:    true,
 				Computed:    true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.OneOf("auto", "us", "eu"),
+				},
 			},
 		},
 	}, nil
@@ -173,6 +184,9 @@ func getCommonWorkspaceFields(data WorkspaceModel) apiclient.CommonWorkspaceFiel
 		b := v.ValueBool()
 		fields.DisplaySetupWizard = &b
 	}
+	if v := data.DefaultGeography; !v.IsUnknown() {
+		fields.DefaultGeography = v.ValueString()
+	}
 	for _, notif := range data.NotificationConfig {
 		n := apiclient.Notification{
 			NotificationType: notif.NotificationType.ValueString(),
@@ -200,18 +214,32 @@ func (r *WorkspaceResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(apiclient.ApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &client
+	r.client = data.Client
+	r.capabilities = data.Client.Capabilities()
+	r.omitsAttributes = !data.Client.ReportsAllAttributes()
+}
+
+func (r *WorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var defaultGeography types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_geography"), &defaultGeography)...)
+	if !defaultGeography.IsNull() && !r.capabilities.Supports(apiclient.CapabilityGeography) {
+		addUnsupportedAttributeError(&resp.Diagnostics, path.Root("default_geography"), "default_geography", r.capabilities, apiclient.CapabilityGeography)
+	}
 }
 
 func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -230,16 +258,23 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 		CommonWorkspaceFields: getCommonWorkspaceFields(plan),
 	}
 
-	workspace, err := r.client.CreateWorkspace(newWorkspace)
+	workspace, err := r.client.CreateWorkspace(ctx, newWorkspace)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			workspacePropertyPaths,
 			"Error creating workspace",
-			"Could not create workspace, unexpected error: "+err.Error(),
+			"Could not create workspace, unexpected error: ",
+			err,
 		)
 		return
 	}
 
 	state := FlattenWorkspace(workspace)
+	omitUnsupportedWorkspaceFields(&state, r.capabilities)
+	if r.omitsAttributes {
+		keepUnreportedWorkspaceFields(&state, plan, &resp.Diagnostics)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -255,13 +290,23 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	workspaceId := state.Id.ValueString()
 
-	workspace, err := r.client.GetWorkspaceById(workspaceId)
+	workspace, err := r.client.GetWorkspaceById(ctx, workspaceId)
 	if err != nil {
+		var notFoundErr *apiclient.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
 		return
 	}
 
+	prior := state
 	state = FlattenWorkspace(workspace)
+	omitUnsupportedWorkspaceFields(&state, r.capabilities)
+	if r.omitsAttributes {
+		keepUnreportedWorkspaceFields(&state, prior, nil)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -282,16 +327,23 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		CommonWorkspaceFields: getCommonWorkspaceFields(plan),
 	}
 
-	workspace, err := r.client.UpdateWorkspace(updatedWorkspace)
+	workspace, err := r.client.UpdateWorkspace(ctx, updatedWorkspace)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addClientErrorDiagnostics(
+			&resp.Diagnostics,
+			workspacePropertyPaths,
 			"Error updating workspace",
-			"Could not update workspace, unexpected error: "+err.Error(),
+			"Could not update workspace, unexpected error: ",
+			err,
 		)
 		return
 	}
 
 	state := FlattenWorkspace(workspace)
+	omitUnsupportedWorkspaceFields(&state, r.capabilities)
+	if r.omitsAttributes {
+		keepUnreportedWorkspaceFields(&state, plan, &resp.Diagnostics)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -306,7 +358,7 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	workspaceId := state.Id.ValueString()
-	err := r.client.DeleteWorkspace(workspaceId)
+	err := r.client.DeleteWorkspace(ctx, workspaceId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating workspace",
@@ -1,12 +1,78 @@
 package provider
 
 import (
+	"context"
 	"regexp"
+	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/apiclient"
 )
 
+// wrappedClient stands for any wrapper around a client, e.g. one adding instrumentation
+type wrappedClient struct {
+	apiclient.Client
+}
+
+func TestWorkspaceResourceConfigureOmitsAttributes(t *testing.T) {
+	for _, tc := range []struct {
+		client          apiclient.Client
+		omitsAttributes bool
+	}{
+		{client: &apiclient.ApiClient{}, omitsAttributes: false},
+		{client: apiclient.NewPublicApiClient(&apiclient.ApiClient{}), omitsAttributes: true},
+		{client: wrappedClient{apiclient.NewPublicApiClient(&apiclient.ApiClient{})}, omitsAttributes: true},
+	} {
+		r := &WorkspaceResource{}
+		resp := &fwresource.ConfigureResponse{}
+		r.Configure(context.Background(), fwresource.ConfigureRequest{ProviderData: &providerData{Client: tc.client}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		if r.omitsAttributes != tc.omitsAttributes {
+			t.Errorf("%T: expected omitsAttributes %t, got %t", tc.client, tc.omitsAttributes, r.omitsAttributes)
+		}
+	}
+}
+
+func TestWorkspaceResourceModifyPlanCapabilities(t *testing.T) {
+	ctx := context.Background()
+	schema, diags := (&WorkspaceResource{}).GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	modifyPlan := func(version string, defaultGeography types.String) *fwresource.ModifyPlanResponse {
+		r := &WorkspaceResource{capabilities: apiclient.Capabilities{Version: version}}
+		config := tfsdk.State{Schema: schema}
+		config.Set(ctx, WorkspaceModel{Name: types.StringValue("test"), DefaultGeography: defaultGeography})
+		plan := tfsdk.Plan{Schema: schema, Raw: config.Raw}
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schema, Raw: config.Raw},
+			Plan:   plan,
+			State:  tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+		}, resp)
+		return resp
+	}
+
+	resp := modifyPlan("0.40.4", types.StringValue("eu"))
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), "requires Airbyte >= "+apiclient.CapabilityGeography.MinVersion) {
+		t.Fatalf("expected a version error at plan time, got: %v", resp.Diagnostics)
+	}
+	if resp := modifyPlan("0.40.4", types.StringNull()); resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error without default_geography, got: %v", resp.Diagnostics)
+	}
+	if resp := modifyPlan(apiclient.CapabilityGeography.MinVersion, types.StringValue("eu")); resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
+
 func TestAccResourceWorkspace_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -42,6 +108,7 @@ func TestAccResourceWorkspace_complex(t *testing.T) {
 					resource.TestCheckResourceAttr("airbyte_workspace.complex", "anonymous_data_collection", "false"),
 					resource.TestCheckResourceAttr("airbyte_workspace.complex", "news", "true"),
 					resource.TestCheckResourceAttr("airbyte_workspace.complex", "security_updates", "true"),
+					resource.TestCheckResourceAttr("airbyte_workspace.complex", "default_geography", "eu"),
 					resource.TestCheckResourceAttr("airbyte_workspace.complex", "notification_config.#", "2"),
 					resource.TestCheckResourceAttr("airbyte_workspace.complex", "notification_config.0.notification_type", "slack"),
 					resource.TestCheckResourceAttr("airbyte_workspace.complex", "notification_config.0.send_on_success", "true"),
@@ -95,6 +162,7 @@ resource "airbyte_workspace" "complex" {
   anonymous_data_collection = false
   news = true
   security_updates = true
+  default_geography = "eu"
   notification_config = [{
     notification_type = "slack"
     send_on_success = true